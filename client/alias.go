@@ -51,14 +51,20 @@ type DescribeAliasParams struct {
 	AliasName string `json:"aliasName"`
 }
 
-func (c *ClientCollection) DescribeAlias(params *DescribeAliasParams) (any, error) {
+type AliasDescription struct {
+	DbName         string `json:"dbName"`
+	AliasName      string `json:"aliasName"`
+	CollectionName string `json:"collectionName"`
+}
+
+func (c *ClientCollection) DescribeAlias(params *DescribeAliasParams) (*AliasDescription, error) {
 	params.DbName = c.dbName
-	var resp zillizResponse[any]
+	var resp zillizResponse[AliasDescription]
 	err := c.do("POST", "v2/vectordb/aliases/describe", params, &resp)
 	if err != nil {
 		return nil, err
 	}
-	return resp.Data, nil
+	return &resp.Data, nil
 }
 
 type AlterAliasesParams struct {
@@ -41,6 +41,40 @@ func (c *Client) GetSecurityGroups(clusterId string) ([]string, error) {
 	return response.Data.Ids, nil
 }
 
+// ip allowlist
+type IpAllowlistEntry struct {
+	Cidr        string `json:"cidr"`
+	Description string `json:"description"`
+}
+
+type UpsertIpAllowlistParams struct {
+	Entries []IpAllowlistEntry `json:"entries"`
+}
+
+// upsert ip allowlist, the entries replace the whole allowlist
+func (c *Client) UpsertIpAllowlist(clusterId string, params *UpsertIpAllowlistParams) (*string, error) {
+	var response zillizResponse[ClusterResponse]
+	err := c.do("PUT", "clusters/"+clusterId+"/ipAllowlist", params, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response.Data.ClusterId, nil
+}
+
+type GetIpAllowlistResponse struct {
+	Entries []IpAllowlistEntry `json:"entries"`
+}
+
+// get ip allowlist
+func (c *Client) GetIpAllowlist(clusterId string) ([]IpAllowlistEntry, error) {
+	var response zillizResponse[GetIpAllowlistResponse]
+	err := c.do("GET", "clusters/"+clusterId+"/ipAllowlist", nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Data.Entries, nil
+}
+
 // suspend cluster
 
 func (c *Client) SuspendCluster(clusterId string) (*string, error) {
@@ -119,9 +153,26 @@ func (c *Client) ModifyClusterAutoscaling(clusterId string, params *ModifyCluste
 	return &response.Data.ClusterId, err
 }
 
-// add or remove cu
+// upgrade plan tier or migrate cu type
+type ModifyClusterPlanParams struct {
+	Plan   string `json:"plan,omitempty"`
+	CuType string `json:"cuType,omitempty"`
+}
+
+func (c *Client) ModifyClusterPlan(clusterId string, params *ModifyClusterPlanParams) (*string, error) {
+	var response zillizResponse[ClusterResponse]
+	err := c.do("POST", "clusters/"+clusterId+"/modify", params, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response.Data.ClusterId, err
+}
+
+// modify cluster properties, only the fields that are set are changed
 type ModifyPropertiesParams struct {
-	ClusterName string `json:"clusterName"`
+	ClusterName           string  `json:"clusterName,omitempty"`
+	Description           *string `json:"description,omitempty"`
+	PublicEndpointEnabled *bool   `json:"publicEndpointEnabled,omitempty"`
 }
 
 func (c *Client) ModifyClusterProperties(clusterId string, params *ModifyPropertiesParams) (*string, error) {
@@ -169,6 +220,91 @@ func (c *Client) GetLabels(clusterId string) (map[string]string, error) {
 	return response.Data.Labels, err
 }
 
+// suspend schedule
+type SuspendSchedule struct {
+	SuspendCron string `json:"suspendCron"`
+	ResumeCron  string `json:"resumeCron"`
+	Timezone    string `json:"timezone"`
+}
+
+func (c *Client) UpdateSuspendSchedule(clusterId string, params *SuspendSchedule) (*string, error) {
+	var response zillizResponse[ClusterResponse]
+	err := c.do("PUT", "clusters/"+clusterId+"/suspendSchedule", params, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response.Data.ClusterId, err
+}
+
+func (c *Client) DeleteSuspendSchedule(clusterId string) (*string, error) {
+	var response zillizResponse[ClusterResponse]
+	err := c.do("DELETE", "clusters/"+clusterId+"/suspendSchedule", nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response.Data.ClusterId, err
+}
+
+// maintenance window
+type MaintenanceWindow struct {
+	DayOfWeek string `json:"dayOfWeek"`
+	StartHour int    `json:"startHour"`
+	Timezone  string `json:"timezone"`
+	Duration  int    `json:"duration"`
+}
+
+type PendingMaintenance struct {
+	StartTime   string `json:"startTime"`
+	Description string `json:"description"`
+}
+
+func (c *Client) UpdateMaintenanceWindow(clusterId string, params *MaintenanceWindow) (*string, error) {
+	var response zillizResponse[ClusterResponse]
+	err := c.do("PUT", "clusters/"+clusterId+"/maintenanceWindow", params, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response.Data.ClusterId, err
+}
+
+func (c *Client) DeleteMaintenanceWindow(clusterId string) (*string, error) {
+	var response zillizResponse[ClusterResponse]
+	err := c.do("DELETE", "clusters/"+clusterId+"/maintenanceWindow", nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response.Data.ClusterId, err
+}
+
+// upgrade the Milvus version of a cluster in place
+type UpgradeClusterVersionParams struct {
+	TargetVersion string `json:"targetVersion"`
+}
+
+func (c *Client) UpgradeClusterVersion(clusterId string, params *UpgradeClusterVersionParams) (*string, error) {
+	var response zillizResponse[ClusterResponse]
+	err := c.do("POST", "clusters/"+clusterId+"/upgradeVersion", params, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response.Data.ClusterId, err
+}
+
+// reset the password of a cluster user without its current password
+type ResetClusterPasswordParams struct {
+	Username string `json:"userName"`
+	Password string `json:"password"`
+}
+
+func (c *Client) ResetClusterPassword(clusterId string, params *ResetClusterPasswordParams) (*string, error) {
+	var response zillizResponse[ClusterResponse]
+	err := c.do("POST", "clusters/"+clusterId+"/resetPassword", params, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response.Data.ClusterId, err
+}
+
 type DropClusterResponse struct {
 	ClusterId string `json:"clusterId"`
 }
@@ -205,6 +341,12 @@ type Cluster struct {
 	Replica            int64             `json:"replica,omitempty"`
 	AwsCseKeyArn       string            `json:"keyIdentifier,omitempty"`
 	Autoscaling        AutoscalingConfig `json:"autoscaling"`
+
+	SuspendSchedule       *SuspendSchedule    `json:"suspendSchedule,omitempty"`
+	PublicEndpointEnabled *bool               `json:"publicEndpointEnabled,omitempty"`
+	MaintenanceWindow     *MaintenanceWindow  `json:"maintenanceWindow,omitempty"`
+	PendingMaintenance    *PendingMaintenance `json:"pendingMaintenance,omitempty"`
+	MilvusVersion         string              `json:"milvusVersion,omitempty"`
 }
 
 type AutoscalingPolicy struct {
@@ -263,6 +405,11 @@ type CreateClusterParams struct {
 	Labels       map[string]string  `json:"labels,omitempty"`
 	BucketInfo   *BucketInfo        `json:"bucketInfo,omitempty"`
 	AwsCseKeyArn *string            `json:"keyIdentifier,omitempty"`
+
+	Description           string             `json:"description,omitempty"`
+	PublicEndpointEnabled *bool              `json:"publicEndpointEnabled,omitempty"`
+	MaintenanceWindow     *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+	MilvusVersion         string             `json:"milvusVersion,omitempty"`
 }
 type BucketInfo struct {
 	BucketName string  `json:"bucketName"`
@@ -274,6 +421,7 @@ type CreateServerlessClusterParams struct {
 	ProjectId   string            `json:"projectId"`
 	RegionId    string            `json:"regionId"`
 	Labels      map[string]string `json:"labels,omitempty"`
+	Description string            `json:"description,omitempty"`
 }
 
 type CreateClusterResponse struct {
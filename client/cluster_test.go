@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
+	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -188,6 +191,164 @@ func TestCreateClusterParamsFixedCUJSON(t *testing.T) {
 	}
 }
 
+func TestModifyClusterPlanParamsJSON(t *testing.T) {
+	payload, err := json.Marshal(ModifyClusterPlanParams{Plan: "Enterprise"})
+	if err != nil {
+		t.Fatalf("marshal params: %v", err)
+	}
+	if string(payload) != `{"plan":"Enterprise"}` {
+		t.Fatalf("payload = %s, want only the plan", payload)
+	}
+
+	payload, err = json.Marshal(ModifyClusterPlanParams{CuType: "Capacity-optimized"})
+	if err != nil {
+		t.Fatalf("marshal params: %v", err)
+	}
+	if string(payload) != `{"cuType":"Capacity-optimized"}` {
+		t.Fatalf("payload = %s, want only the cuType", payload)
+	}
+}
+
+func TestUnitSuspendScheduleEndpoints(t *testing.T) {
+	var got []string
+	c := newMockClient(t, func(req *http.Request) (*http.Response, error) {
+		var body string
+		if req.Body != nil {
+			b, _ := io.ReadAll(req.Body)
+			body = string(b)
+		}
+		got = append(got, req.Method+" "+req.URL.Path+" "+body)
+		return jsonResponse(t, map[string]any{"code": 0, "data": map[string]any{"clusterId": "in01-test"}}), nil
+	})
+
+	if _, err := c.UpdateSuspendSchedule("in01-test", &SuspendSchedule{SuspendCron: "0 20 * * 1-5", ResumeCron: "0 8 * * 1-5", Timezone: "Etc/UTC"}); err != nil {
+		t.Fatalf("UpdateSuspendSchedule: %v", err)
+	}
+	if _, err := c.DeleteSuspendSchedule("in01-test"); err != nil {
+		t.Fatalf("DeleteSuspendSchedule: %v", err)
+	}
+
+	want := []string{
+		`PUT /v2/clusters/in01-test/suspendSchedule {"suspendCron":"0 20 * * 1-5","resumeCron":"0 8 * * 1-5","timezone":"Etc/UTC"}`,
+		"DELETE /v2/clusters/in01-test/suspendSchedule ",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("requests = %q, want %q", got, want)
+	}
+	for i := range want {
+		if strings.TrimSpace(got[i]) != strings.TrimSpace(want[i]) {
+			t.Errorf("request %d = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestUnitMaintenanceWindowEndpoints(t *testing.T) {
+	var got []string
+	c := newMockClient(t, func(req *http.Request) (*http.Response, error) {
+		var body string
+		if req.Body != nil {
+			b, _ := io.ReadAll(req.Body)
+			body = string(b)
+		}
+		got = append(got, req.Method+" "+req.URL.Path+" "+body)
+		return jsonResponse(t, map[string]any{"code": 0, "data": map[string]any{"clusterId": "in01-test"}}), nil
+	})
+
+	if _, err := c.UpdateMaintenanceWindow("in01-test", &MaintenanceWindow{DayOfWeek: "SUNDAY", StartHour: 2, Timezone: "Etc/UTC", Duration: 4}); err != nil {
+		t.Fatalf("UpdateMaintenanceWindow: %v", err)
+	}
+	if _, err := c.DeleteMaintenanceWindow("in01-test"); err != nil {
+		t.Fatalf("DeleteMaintenanceWindow: %v", err)
+	}
+
+	want := []string{
+		`PUT /v2/clusters/in01-test/maintenanceWindow {"dayOfWeek":"SUNDAY","startHour":2,"timezone":"Etc/UTC","duration":4}`,
+		"DELETE /v2/clusters/in01-test/maintenanceWindow ",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("requests = %q, want %q", got, want)
+	}
+	for i := range want {
+		if strings.TrimSpace(got[i]) != strings.TrimSpace(want[i]) {
+			t.Errorf("request %d = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestUnitIpAllowlistEndpoints(t *testing.T) {
+	var got []string
+	c := newMockClient(t, func(req *http.Request) (*http.Response, error) {
+		var body string
+		if req.Body != nil {
+			b, _ := io.ReadAll(req.Body)
+			body = string(b)
+		}
+		got = append(got, req.Method+" "+req.URL.Path+" "+body)
+		if req.Method == http.MethodGet {
+			return jsonResponse(t, map[string]any{"code": 0, "data": map[string]any{
+				"entries": []map[string]any{{"cidr": "203.0.113.0/24", "description": "office"}},
+			}}), nil
+		}
+		return jsonResponse(t, map[string]any{"code": 0, "data": map[string]any{"clusterId": "in01-test"}}), nil
+	})
+
+	if _, err := c.UpsertIpAllowlist("in01-test", &UpsertIpAllowlistParams{Entries: []IpAllowlistEntry{{Cidr: "203.0.113.0/24", Description: "office"}}}); err != nil {
+		t.Fatalf("UpsertIpAllowlist: %v", err)
+	}
+	entries, err := c.GetIpAllowlist("in01-test")
+	if err != nil {
+		t.Fatalf("GetIpAllowlist: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Cidr != "203.0.113.0/24" || entries[0].Description != "office" {
+		t.Fatalf("entries = %+v, want the office range", entries)
+	}
+
+	want := []string{
+		`PUT /v2/clusters/in01-test/ipAllowlist {"entries":[{"cidr":"203.0.113.0/24","description":"office"}]}`,
+		"GET /v2/clusters/in01-test/ipAllowlist ",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("requests = %q, want %q", got, want)
+	}
+	for i := range want {
+		if strings.TrimSpace(got[i]) != strings.TrimSpace(want[i]) {
+			t.Errorf("request %d = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestUnitUpgradeClusterVersion(t *testing.T) {
+	var got string
+	c := newMockClient(t, func(req *http.Request) (*http.Response, error) {
+		b, _ := io.ReadAll(req.Body)
+		got = req.Method + " " + req.URL.Path + " " + string(b)
+		return jsonResponse(t, map[string]any{"code": 0, "data": map[string]any{"clusterId": "in01-test"}}), nil
+	})
+
+	if _, err := c.UpgradeClusterVersion("in01-test", &UpgradeClusterVersionParams{TargetVersion: "2.5.4"}); err != nil {
+		t.Fatalf("UpgradeClusterVersion: %v", err)
+	}
+	if want := `POST /v2/clusters/in01-test/upgradeVersion {"targetVersion":"2.5.4"}`; strings.TrimSpace(got) != want {
+		t.Errorf("request = %q, want %q", got, want)
+	}
+}
+
+func TestUnitResetClusterPassword(t *testing.T) {
+	var got string
+	c := newMockClient(t, func(req *http.Request) (*http.Response, error) {
+		b, _ := io.ReadAll(req.Body)
+		got = req.Method + " " + req.URL.Path + " " + string(b)
+		return jsonResponse(t, map[string]any{"code": 0, "data": map[string]any{"clusterId": "in01-test"}}), nil
+	})
+
+	if _, err := c.ResetClusterPassword("in01-test", &ResetClusterPasswordParams{Username: "db_admin", Password: "n3w-Passw0rd"}); err != nil {
+		t.Fatalf("ResetClusterPassword: %v", err)
+	}
+	if want := `POST /v2/clusters/in01-test/resetPassword {"userName":"db_admin","password":"n3w-Passw0rd"}`; strings.TrimSpace(got) != want {
+		t.Errorf("request = %q, want %q", got, want)
+	}
+}
+
 func TestCreateClusterParamsAutoscalingJSON(t *testing.T) {
 	minCU := 4
 	maxCU := 8
@@ -238,6 +399,31 @@ func TestCreateClusterParamsAutoscalingJSON(t *testing.T) {
 	}
 }
 
+func TestModifyPropertiesParamsJSON(t *testing.T) {
+	disabled := false
+	tests := []struct {
+		name   string
+		params ModifyPropertiesParams
+		want   string
+	}{
+		{name: "name only", params: ModifyPropertiesParams{ClusterName: "renamed"}, want: `{"clusterName":"renamed"}`},
+		{name: "clear description", params: ModifyPropertiesParams{Description: conv.StringPtr("")}, want: `{"description":""}`},
+		{name: "disable public endpoint", params: ModifyPropertiesParams{PublicEndpointEnabled: &disabled}, want: `{"publicEndpointEnabled":false}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload, err := json.Marshal(tt.params)
+			if err != nil {
+				t.Fatalf("marshal params: %v", err)
+			}
+			if string(payload) != tt.want {
+				t.Fatalf("payload = %s, want %s", payload, tt.want)
+			}
+		})
+	}
+}
+
 func TestClient_ServerlessCluster(t *testing.T) {
 	var clusterId string
 	var projectID string
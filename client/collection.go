@@ -18,7 +18,7 @@ func (c *Client) Collection(connectAddress, dbName string) (*ClientCollection, e
 
 type CollectionSchema struct {
 	AutoID              bool                    `json:"autoId"`
-	EnabledDynamicField bool                    `json:"enabledDynamicField"`
+	EnabledDynamicField bool                    `json:"enableDynamicField"`
 	Fields              []CollectionSchemaField `json:"fields"`
 }
 type CollectionSchemaField struct {
@@ -26,12 +26,14 @@ type CollectionSchemaField struct {
 	DataType          string         `json:"dataType"`
 	ElementDataType   string         `json:"elementDataType,omitempty"`
 	IsPrimary         bool           `json:"isPrimary"`
+	IsPartitionKey    bool           `json:"isPartitionKey,omitempty"`
 	ElementTypeParams map[string]any `json:"elementTypeParams"`
 }
 
 type CreateCollectionParams struct {
 	DbName         string           `json:"dbName"`
 	CollectionName string           `json:"collectionName"`
+	Description    string           `json:"description,omitempty"`
 	Schema         CollectionSchema `json:"schema"`
 	Params         map[string]any   `json:"params"`
 }
@@ -61,6 +63,13 @@ func (c *ClientCollection) DropCollection(params *DropCollectionParams) error {
 	return nil
 }
 
+// Load states reported in CollectionDescription.Load.
+const (
+	CollectionLoadStateLoaded  = "LoadStateLoaded"
+	CollectionLoadStateLoading = "LoadStateLoading"
+	CollectionLoadStateNotLoad = "LoadStateNotLoad"
+)
+
 type CollectionDescription struct {
 	Aliases            []string             `json:"aliases"`
 	AutoID             bool                 `json:"autoId"`
@@ -124,6 +133,25 @@ func (c *ClientCollection) DescribeCollection(params *DescribeCollectionParams)
 	return resp.Data, nil
 }
 
+type GetCollectionStatsParams struct {
+	DbName         string `json:"dbName"`
+	CollectionName string `json:"collectionName"`
+}
+
+type CollectionStats struct {
+	RowCount int64 `json:"rowCount"`
+}
+
+func (c *ClientCollection) GetCollectionStats(params *GetCollectionStatsParams) (*CollectionStats, error) {
+	params.DbName = c.dbName
+	var resp zillizResponse[CollectionStats]
+	err := c.do("POST", "v2/vectordb/collections/get_stats", params, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
 type LoadCollectionParams struct {
 	DbName         string `json:"dbName"`
 	CollectionName string `json:"collectionName"`
@@ -183,3 +211,36 @@ func (c *ClientCollection) AlterCollectionProperties(params *AlterCollectionProp
 	}
 	return nil
 }
+
+type DropCollectionPropertiesParams struct {
+	DbName         string   `json:"dbName"`
+	CollectionName string   `json:"collectionName"`
+	PropertyKeys   []string `json:"propertyKeys"`
+}
+
+func (c *ClientCollection) DropCollectionProperties(params *DropCollectionPropertiesParams) error {
+	params.DbName = c.dbName
+	var resp zillizResponse[any]
+	err := c.do("POST", "v2/vectordb/collections/drop_properties", params, &resp)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type AlterFieldPropertiesParams struct {
+	DbName         string         `json:"dbName"`
+	CollectionName string         `json:"collectionName"`
+	FieldName      string         `json:"fieldName"`
+	FieldParams    map[string]any `json:"fieldParams"`
+}
+
+func (c *ClientCollection) AlterFieldProperties(params *AlterFieldPropertiesParams) error {
+	params.DbName = c.dbName
+	var resp zillizResponse[any]
+	err := c.do("POST", "v2/vectordb/collections/fields/alter_properties", params, &resp)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -71,6 +71,20 @@ func (c *ClientCluster) UpdateDatabase(params UpdateDatabaseParams) (any, error)
 	return resp.Data, nil
 }
 
+type DropDatabasePropertiesParams struct {
+	DbName       string   `json:"dbName"`
+	PropertyKeys []string `json:"propertyKeys"`
+}
+
+func (c *ClientCluster) DropDatabaseProperties(params DropDatabasePropertiesParams) error {
+	var resp zillizResponse[any]
+	err := c.do("POST", "v2/vectordb/databases/drop_properties", params, &resp)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 func (c *ClientCluster) ListDatabases() ([]string, error) {
 	var resp zillizResponse[[]string]
 	err := c.do("POST", "v2/vectordb/databases/list", map[string]any{}, &resp)
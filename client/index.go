@@ -43,8 +43,26 @@ type DescribeIndexParams struct {
 	IndexName      string `json:"indexName"`
 }
 
-func (c *ClientCollection) DescribeIndex(params *DescribeIndexParams) (any, error) {
-	var resp zillizResponse[any]
+// Build states reported in IndexDescription.IndexState.
+const (
+	IndexStateFinished = "Finished"
+	IndexStateFailed   = "Failed"
+)
+
+type IndexDescription struct {
+	FieldName   string `json:"fieldName"`
+	IndexName   string `json:"indexName"`
+	IndexType   string `json:"indexType"`
+	MetricType  string `json:"metricType"`
+	IndexState  string `json:"indexState"`
+	PendingRows int64  `json:"pendingRows"`
+	IndexedRows int64  `json:"indexedRows"`
+	TotalRows   int64  `json:"totalRows"`
+	FailReason  string `json:"failReason"`
+}
+
+func (c *ClientCollection) DescribeIndex(params *DescribeIndexParams) ([]IndexDescription, error) {
+	var resp zillizResponse[[]IndexDescription]
 	err := c.do("POST", "v2/vectordb/indexes/describe", params, &resp)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,90 @@
+package client
+
+import (
+	"sort"
+	"strings"
+)
+
+type ClientPrivilegeGroup struct {
+	*Client
+}
+
+func (c *Client) PrivilegeGroup(connectAddress string) (*ClientPrivilegeGroup, error) {
+	cu, err := c.cluster(connectAddress)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientPrivilegeGroup{cu}, nil
+}
+
+// BuiltinPrivilegeGroups are the privilege groups predefined by Milvus 2.5.
+var BuiltinPrivilegeGroups = []string{
+	"COLL_RO", "COLL_RW", "COLL_ADMIN",
+	"DB_RO", "DB_RW", "DB_Admin",
+	"Cluster_RO", "Cluster_RW", "Cluster_Admin",
+}
+
+type PrivilegeGroup struct {
+	Name       string
+	Privileges []string
+}
+
+type privilegeGroupItem struct {
+	PrivilegeGroupName string `json:"privilegeGroupName"`
+	Privileges         string `json:"privileges"`
+}
+
+type listPrivilegeGroupsResponse struct {
+	PrivilegeGroups []privilegeGroupItem `json:"privilegeGroups"`
+}
+
+func (c *ClientPrivilegeGroup) ListPrivilegeGroups() ([]PrivilegeGroup, error) {
+	var resp zillizResponse[listPrivilegeGroupsResponse]
+	empty := map[string]any{}
+	err := c.do("POST", "v2/vectordb/privilege_groups/list", empty, &resp)
+	if err != nil {
+		return nil, err
+	}
+	groups := make([]PrivilegeGroup, 0, len(resp.Data.PrivilegeGroups))
+	for _, item := range resp.Data.PrivilegeGroups {
+		var privileges []string
+		for _, p := range strings.Split(item.Privileges, ",") {
+			if p = strings.TrimSpace(p); p != "" {
+				privileges = append(privileges, p)
+			}
+		}
+		sort.Strings(privileges)
+		groups = append(groups, PrivilegeGroup{Name: item.PrivilegeGroupName, Privileges: privileges})
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].Name < groups[j].Name })
+	return groups, nil
+}
+
+type PrivilegeGroupParams struct {
+	PrivilegeGroupName string `json:"privilegeGroupName"`
+}
+
+func (c *ClientPrivilegeGroup) CreatePrivilegeGroup(req *PrivilegeGroupParams) error {
+	var resp zillizResponse[any]
+	return c.do("POST", "v2/vectordb/privilege_groups/create", req, &resp)
+}
+
+func (c *ClientPrivilegeGroup) DropPrivilegeGroup(req *PrivilegeGroupParams) error {
+	var resp zillizResponse[any]
+	return c.do("POST", "v2/vectordb/privilege_groups/drop", req, &resp)
+}
+
+type PrivilegeGroupPrivilegesParams struct {
+	PrivilegeGroupName string   `json:"privilegeGroupName"`
+	Privileges         []string `json:"privileges"`
+}
+
+func (c *ClientPrivilegeGroup) AddPrivilegesToGroup(req *PrivilegeGroupPrivilegesParams) error {
+	var resp zillizResponse[any]
+	return c.do("POST", "v2/vectordb/privilege_groups/add_privileges_to_group", req, &resp)
+}
+
+func (c *ClientPrivilegeGroup) RemovePrivilegesFromGroup(req *PrivilegeGroupPrivilegesParams) error {
+	var resp zillizResponse[any]
+	return c.do("POST", "v2/vectordb/privilege_groups/remove_privileges_from_group", req, &resp)
+}
@@ -0,0 +1,67 @@
+package client
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+func TestUnitListPrivilegeGroups(t *testing.T) {
+	c := newMockClient(t, func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/v2/vectordb/privilege_groups/list" {
+			t.Errorf("path=%s", req.URL.Path)
+		}
+		return jsonResponse(t, map[string]any{
+			"code": 0,
+			"data": map[string]any{
+				"privilegeGroups": []map[string]any{
+					{"privilegeGroupName": "team_readers", "privileges": "Search, Query"},
+					{"privilegeGroupName": "COLL_RO", "privileges": "Query,Search,DescribeCollection"},
+				},
+			},
+		}), nil
+	})
+	pg, err := c.PrivilegeGroup("https://in01-test.zillizcloud.com:19530")
+	if err != nil {
+		t.Fatalf("PrivilegeGroup: %v", err)
+	}
+
+	groups, err := pg.ListPrivilegeGroups()
+	if err != nil {
+		t.Fatalf("ListPrivilegeGroups: %v", err)
+	}
+	if len(groups) != 2 || groups[0].Name != "COLL_RO" || groups[1].Name != "team_readers" {
+		t.Fatalf("groups=%v, want COLL_RO then team_readers", groups)
+	}
+	if !slices.Equal(groups[1].Privileges, []string{"Query", "Search"}) {
+		t.Errorf("team_readers privileges=%v", groups[1].Privileges)
+	}
+}
+
+func TestUnitAddPrivilegesToGroup(t *testing.T) {
+	c := newMockClient(t, func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/v2/vectordb/privilege_groups/add_privileges_to_group" {
+			t.Errorf("path=%s", req.URL.Path)
+		}
+		b, _ := io.ReadAll(req.Body)
+		var body PrivilegeGroupPrivilegesParams
+		if err := json.Unmarshal(b, &body); err != nil {
+			t.Fatalf("unmarshal: %v", err)
+		}
+		if body.PrivilegeGroupName != "team_readers" || !slices.Equal(body.Privileges, []string{"Search"}) {
+			t.Errorf("body=%+v", body)
+		}
+		return jsonResponse(t, map[string]any{"code": 0, "data": map[string]any{}}), nil
+	})
+	pg, err := c.PrivilegeGroup("https://in01-test.zillizcloud.com:19530")
+	if err != nil {
+		t.Fatalf("PrivilegeGroup: %v", err)
+	}
+
+	err = pg.AddPrivilegesToGroup(&PrivilegeGroupPrivilegesParams{PrivilegeGroupName: "team_readers", Privileges: []string{"Search"}})
+	if err != nil {
+		t.Fatalf("AddPrivilegesToGroup: %v", err)
+	}
+}
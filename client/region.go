@@ -3,14 +3,45 @@ package client
 import (
 	"net/url"
 	"strings"
+	"sync"
 )
 
 type CloudRegion struct {
-	ApiBaseUrl            string   `json:"apiBaseUrl"`
-	CloudId               string   `json:"cloudId"`
-	RegionId              string   `json:"regionId"`
-	Domain                string   `json:"domain"`
-	SupportedClusterTypes []string `json:"supportedClusterTypes"`
+	ApiBaseUrl            string              `json:"apiBaseUrl"`
+	CloudId               string              `json:"cloudId"`
+	RegionId              string              `json:"regionId"`
+	Domain                string              `json:"domain"`
+	SupportedClusterTypes []string            `json:"supportedClusterTypes"`
+	Capabilities          *RegionCapabilities `json:"capabilities,omitempty"`
+}
+
+// RegionCapabilities describes the cluster shapes a region accepts. Empty
+// lists mean the region does not restrict that dimension.
+type RegionCapabilities struct {
+	Plans   []string           `json:"plans,omitempty"`
+	CuTypes []CuTypeCapability `json:"cuTypes,omitempty"`
+}
+
+type CuTypeCapability struct {
+	CuType     string   `json:"cuType"`
+	Plans      []string `json:"plans,omitempty"`
+	CuSizes    []int64  `json:"cuSizes,omitempty"`
+	MaxReplica int64    `json:"maxReplica,omitempty"`
+}
+
+func (r *RegionCapabilities) CuType(cuType string) *CuTypeCapability {
+	for i := range r.CuTypes {
+		if r.CuTypes[i].CuType == cuType {
+			return &r.CuTypes[i]
+		}
+	}
+	return nil
+}
+
+type regionCache struct {
+	mu sync.Mutex
+	// regions holds the listed regions by region id, per API base url.
+	regions map[string]map[string]CloudRegion
 }
 
 func (c *Client) ListCloudRegions(cloudId string) ([]CloudRegion, error) {
@@ -25,6 +56,57 @@ func (c *Client) ListCloudRegions(cloudId string) ([]CloudRegion, error) {
 	return cloudRegions.Data, err
 }
 
+// DescribeCloudRegion returns the region with the given id, or nil when the
+// API does not list it. The region list is fetched once per base url and
+// cached for the lifetime of the client.
+func (c *Client) DescribeCloudRegion(regionId string) (*CloudRegion, error) {
+	cache := c.regions
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	regions, ok := cache.regions[c.baseUrl]
+	if !ok {
+		list, err := c.ListCloudRegions("")
+		if err != nil {
+			return nil, err
+		}
+		regions = make(map[string]CloudRegion, len(list))
+		for _, region := range list {
+			regions[region.RegionId] = region
+		}
+		if cache.regions == nil {
+			cache.regions = map[string]map[string]CloudRegion{}
+		}
+		cache.regions[c.baseUrl] = regions
+	}
+
+	region, ok := regions[regionId]
+	if !ok {
+		return nil, nil
+	}
+	return &region, nil
+}
+
+// MilvusVersion is a Milvus version clusters in a region can run.
+type MilvusVersion struct {
+	Version   string `json:"version"`
+	IsDefault bool   `json:"isDefault"`
+}
+
+// ListMilvusVersions lists the Milvus versions available in the given region,
+// in ascending order.
+func (c *Client) ListMilvusVersions(regionId string) ([]MilvusVersion, error) {
+	if regionId == "" {
+		regionId = c.RegionId
+	}
+	if regionId == "" {
+		return nil, errRegionIdRequired
+	}
+	var response zillizResponse[[]MilvusVersion]
+	err := c.do("GET", "regions/"+url.PathEscape(regionId)+"/milvusVersions", nil, &response)
+	return response.Data, err
+}
+
 func BaseUrlFrom(cloudRegionId string) string {
 	tokens := strings.Split(cloudRegionId, "-")
 
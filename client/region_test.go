@@ -106,3 +106,87 @@ func TestUnitListCloudRegionsWithCloudId(t *testing.T) {
 		t.Errorf("region=%+v", got[0])
 	}
 }
+
+func TestUnitDescribeCloudRegionCachesCapabilities(t *testing.T) {
+	calls := 0
+	c := newMockClient(t, func(req *http.Request) (*http.Response, error) {
+		calls++
+		if req.URL.Path != "/v2/regions" {
+			t.Errorf("path=%s", req.URL.Path)
+		}
+		return jsonResponse(t, map[string]any{
+			"code": 0,
+			"data": []map[string]any{
+				{
+					"cloudId":               "aws",
+					"regionId":              "aws-us-west-2",
+					"supportedClusterTypes": []string{"serverless", "dedicated"},
+					"capabilities": map[string]any{
+						"plans": []string{"Standard", "Enterprise"},
+						"cuTypes": []map[string]any{
+							{"cuType": "Performance-optimized", "cuSizes": []int{1, 2, 4, 8}, "maxReplica": 4},
+							{"cuType": "Capacity-optimized", "cuSizes": []int{4, 8}, "plans": []string{"Enterprise"}},
+						},
+					},
+				},
+			},
+		}), nil
+	})
+
+	got, err := c.DescribeCloudRegion("aws-us-west-2")
+	if err != nil {
+		t.Fatalf("failed to DescribeCloudRegion: %v", err)
+	}
+	if got == nil || got.Capabilities == nil {
+		t.Fatalf("region=%+v, want capabilities", got)
+	}
+	capacity := got.Capabilities.CuType("Capacity-optimized")
+	if capacity == nil || len(capacity.CuSizes) != 2 || capacity.Plans[0] != "Enterprise" {
+		t.Errorf("capacity-optimized=%+v", capacity)
+	}
+	if got.Capabilities.CuType("Tiered-storage") != nil {
+		t.Errorf("tiered-storage should not be listed")
+	}
+
+	// The clone shares the cache, so neither lookup hits the API again.
+	clone, err := c.Clone()
+	if err != nil {
+		t.Fatalf("Clone: %v", err)
+	}
+	missing, err := clone.DescribeCloudRegion("aws-eu-central-1")
+	if err != nil {
+		t.Fatalf("failed to DescribeCloudRegion: %v", err)
+	}
+	if missing != nil {
+		t.Errorf("missing region=%+v, want nil", missing)
+	}
+	if calls != 1 {
+		t.Errorf("calls=%d, want 1", calls)
+	}
+}
+
+func TestUnitListMilvusVersions(t *testing.T) {
+	c := newMockClient(t, func(req *http.Request) (*http.Response, error) {
+		if req.Method != "GET" {
+			t.Errorf("method=%s", req.Method)
+		}
+		if req.URL.Path != "/v2/regions/aws-us-west-2/milvusVersions" {
+			t.Errorf("path=%s", req.URL.Path)
+		}
+		return jsonResponse(t, map[string]any{
+			"code": 0,
+			"data": []map[string]any{
+				{"version": "2.4.17"},
+				{"version": "2.5.4", "isDefault": true},
+			},
+		}), nil
+	})
+
+	got, err := c.ListMilvusVersions("aws-us-west-2")
+	if err != nil {
+		t.Fatalf("failed to ListMilvusVersions: %v", err)
+	}
+	if len(got) != 2 || got[1].Version != "2.5.4" || !got[1].IsDefault || got[0].IsDefault {
+		t.Errorf("versions=%+v", got)
+	}
+}
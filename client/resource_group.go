@@ -0,0 +1,103 @@
+package client
+
+import "sort"
+
+type ClientResourceGroup struct {
+	*Client
+}
+
+func (c *Client) ResourceGroup(connectAddress string) (*ClientResourceGroup, error) {
+	cu, err := c.cluster(connectAddress)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientResourceGroup{cu}, nil
+}
+
+// DefaultResourceGroup is the resource group every query node starts in.
+const DefaultResourceGroup = "__default_resource_group"
+
+type ResourceGroupNodeNum struct {
+	NodeNum int64 `json:"node_num"`
+}
+
+type ResourceGroupTransfer struct {
+	ResourceGroup string `json:"resource_group"`
+}
+
+type ResourceGroupConfig struct {
+	Requests     ResourceGroupNodeNum    `json:"requests"`
+	Limits       ResourceGroupNodeNum    `json:"limits"`
+	TransferFrom []ResourceGroupTransfer `json:"transfer_from,omitempty"`
+	TransferTo   []ResourceGroupTransfer `json:"transfer_to,omitempty"`
+}
+
+type ResourceGroupNode struct {
+	NodeId   int64  `json:"node_id"`
+	Address  string `json:"address"`
+	Hostname string `json:"hostname"`
+}
+
+type ResourceGroup struct {
+	Name             string              `json:"name"`
+	Capacity         int64               `json:"capacity"`
+	NumAvailableNode int64               `json:"num_available_node"`
+	Config           ResourceGroupConfig `json:"config"`
+	Nodes            []ResourceGroupNode `json:"nodes"`
+}
+
+type CreateResourceGroupParams struct {
+	Name   string               `json:"name"`
+	Config *ResourceGroupConfig `json:"config,omitempty"`
+}
+
+func (c *ClientResourceGroup) CreateResourceGroup(params *CreateResourceGroupParams) error {
+	var resp zillizResponse[any]
+	return c.do("POST", "v2/vectordb/resource_groups/create", params, &resp)
+}
+
+type ResourceGroupNameParams struct {
+	Name string `json:"name"`
+}
+
+type describeResourceGroupResponse struct {
+	ResourceGroup ResourceGroup `json:"resource_group"`
+}
+
+func (c *ClientResourceGroup) DescribeResourceGroup(name string) (*ResourceGroup, error) {
+	var resp zillizResponse[describeResourceGroupResponse]
+	err := c.do("POST", "v2/vectordb/resource_groups/describe", &ResourceGroupNameParams{Name: name}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Data.ResourceGroup, nil
+}
+
+func (c *ClientResourceGroup) ListResourceGroups() ([]string, error) {
+	var resp zillizResponse[[]string]
+	empty := map[string]any{}
+	err := c.do("POST", "v2/vectordb/resource_groups/list", empty, &resp)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(resp.Data)
+	return resp.Data, nil
+}
+
+type UpdateResourceGroupsParams struct {
+	ResourceGroups map[string]*ResourceGroupConfig `json:"resource_groups"`
+}
+
+// UpdateResourceGroups changes the node requests, limits and transfer rules of
+// resource groups. Query nodes are moved between groups to meet the new config.
+func (c *ClientResourceGroup) UpdateResourceGroups(params *UpdateResourceGroupsParams) error {
+	var resp zillizResponse[any]
+	return c.do("POST", "v2/vectordb/resource_groups/alter", params, &resp)
+}
+
+// DropResourceGroup drops a resource group. The group must not hold any nodes,
+// so its requests and limits have to be set to zero first.
+func (c *ClientResourceGroup) DropResourceGroup(name string) error {
+	var resp zillizResponse[any]
+	return c.do("POST", "v2/vectordb/resource_groups/drop", &ResourceGroupNameParams{Name: name}, &resp)
+}
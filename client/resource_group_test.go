@@ -0,0 +1,75 @@
+package client
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestUnitDescribeResourceGroup(t *testing.T) {
+	c := newMockClient(t, func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/v2/vectordb/resource_groups/describe" {
+			t.Errorf("path=%s", req.URL.Path)
+		}
+		return jsonResponse(t, map[string]any{
+			"code": 0,
+			"data": map[string]any{
+				"resource_group": map[string]any{
+					"name":               "tenant_a",
+					"capacity":           2,
+					"num_available_node": 2,
+					"config": map[string]any{
+						"requests":      map[string]any{"node_num": 2},
+						"limits":        map[string]any{"node_num": 3},
+						"transfer_from": []map[string]any{{"resource_group": DefaultResourceGroup}},
+					},
+				},
+			},
+		}), nil
+	})
+	rg, err := c.ResourceGroup("https://in01-test.zillizcloud.com:19530")
+	if err != nil {
+		t.Fatalf("ResourceGroup: %v", err)
+	}
+
+	got, err := rg.DescribeResourceGroup("tenant_a")
+	if err != nil {
+		t.Fatalf("DescribeResourceGroup: %v", err)
+	}
+	if got.Name != "tenant_a" || got.Config.Requests.NodeNum != 2 || got.Config.Limits.NodeNum != 3 {
+		t.Errorf("resource group=%+v", got)
+	}
+	if len(got.Config.TransferFrom) != 1 || got.Config.TransferFrom[0].ResourceGroup != DefaultResourceGroup {
+		t.Errorf("transfer_from=%+v", got.Config.TransferFrom)
+	}
+}
+
+func TestUnitUpdateResourceGroups(t *testing.T) {
+	c := newMockClient(t, func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/v2/vectordb/resource_groups/alter" {
+			t.Errorf("path=%s", req.URL.Path)
+		}
+		b, _ := io.ReadAll(req.Body)
+		var body map[string]map[string]map[string]any
+		if err := json.Unmarshal(b, &body); err != nil {
+			t.Fatalf("unmarshal: %v", err)
+		}
+		cfg := body["resource_groups"]["tenant_a"]
+		if cfg["requests"].(map[string]any)["node_num"] != float64(0) || cfg["limits"].(map[string]any)["node_num"] != float64(0) {
+			t.Errorf("body=%s", b)
+		}
+		return jsonResponse(t, map[string]any{"code": 0, "data": map[string]any{}}), nil
+	})
+	rg, err := c.ResourceGroup("https://in01-test.zillizcloud.com:19530")
+	if err != nil {
+		t.Fatalf("ResourceGroup: %v", err)
+	}
+
+	err = rg.UpdateResourceGroups(&UpdateResourceGroupsParams{
+		ResourceGroups: map[string]*ResourceGroupConfig{"tenant_a": {}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateResourceGroups: %v", err)
+	}
+}
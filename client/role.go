@@ -26,3 +26,79 @@ func (c *ClientRole) ListRoles() (Roles, error) {
 	sort.Strings(rolesResponse.Data)
 	return rolesResponse.Data, nil
 }
+
+type CreateRoleParams struct {
+	RoleName string `json:"roleName"`
+}
+
+func (c *ClientRole) CreateRole(req *CreateRoleParams) error {
+	var resp zillizResponse[any]
+	return c.do("POST", "v2/vectordb/roles/create", req, &resp)
+}
+
+type DropRoleParams struct {
+	RoleName string `json:"roleName"`
+}
+
+func (c *ClientRole) DropRole(req *DropRoleParams) error {
+	var resp zillizResponse[any]
+	return c.do("POST", "v2/vectordb/roles/drop", req, &resp)
+}
+
+type DescribeRoleParams struct {
+	RoleName string `json:"roleName"`
+}
+
+// RolePrivilege is a privilege granted to a role on an object.
+type RolePrivilege struct {
+	ObjectType string `json:"objectType"`
+	ObjectName string `json:"objectName"`
+	DbName     string `json:"dbName"`
+	Privilege  string `json:"privilege"`
+	Grantor    string `json:"grantor,omitempty"`
+}
+
+func (c *ClientRole) DescribeRole(req *DescribeRoleParams) ([]RolePrivilege, error) {
+	var resp zillizResponse[[]RolePrivilege]
+	err := c.do("POST", "v2/vectordb/roles/describe", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+type RolePrivilegeParams struct {
+	RoleName   string `json:"roleName"`
+	ObjectType string `json:"objectType"`
+	ObjectName string `json:"objectName"`
+	DbName     string `json:"dbName,omitempty"`
+	Privilege  string `json:"privilege"`
+}
+
+func (c *ClientRole) GrantPrivilege(req *RolePrivilegeParams) error {
+	var resp zillizResponse[any]
+	return c.do("POST", "v2/vectordb/roles/grant_privilege", req, &resp)
+}
+
+func (c *ClientRole) RevokePrivilege(req *RolePrivilegeParams) error {
+	var resp zillizResponse[any]
+	return c.do("POST", "v2/vectordb/roles/revoke_privilege", req, &resp)
+}
+
+// RolePrivilegeV2Params grants a privilege or privilege group on a database and collection.
+type RolePrivilegeV2Params struct {
+	RoleName       string `json:"roleName"`
+	Privilege      string `json:"privilege"`
+	DbName         string `json:"dbName"`
+	CollectionName string `json:"collectionName"`
+}
+
+func (c *ClientRole) GrantPrivilegeV2(req *RolePrivilegeV2Params) error {
+	var resp zillizResponse[any]
+	return c.do("POST", "v2/vectordb/roles/grant_privilege_v2", req, &resp)
+}
+
+func (c *ClientRole) RevokePrivilegeV2(req *RolePrivilegeV2Params) error {
+	var resp zillizResponse[any]
+	return c.do("POST", "v2/vectordb/roles/revoke_privilege_v2", req, &resp)
+}
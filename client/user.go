@@ -64,6 +64,19 @@ func (c *ClientUser) DropUser(req *DropUserParams) error {
 	return c.do("POST", "v2/vectordb/users/drop", req, &resp)
 }
 
+type UpdatePasswordParams struct {
+	Username    string `json:"userName"`
+	Password    string `json:"password"`
+	NewPassword string `json:"newPassword"`
+}
+
+// UpdatePassword changes the password of an existing user in place, so the
+// roles granted to the user are kept.
+func (c *ClientUser) UpdatePassword(req *UpdatePasswordParams) error {
+	var resp zillizResponse[any]
+	return c.do("POST", "v2/vectordb/users/update_password", req, &resp)
+}
+
 type UserGrantRoleToUserParams struct {
 	UserName string `json:"userName"`
 	RoleName string `json:"roleName"`
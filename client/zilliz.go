@@ -37,6 +37,9 @@ type Client struct {
 	traceId        string
 	logHttpTraffic bool
 	rateLimiter    *rate.Limiter
+
+	// regions caches region lookups once per provider instance.
+	regions *regionCache
 }
 
 var (
@@ -134,6 +137,7 @@ func applyDefaults(c *Client) {
 		WithDefaultTraceID(),
 		WithDefaultHttpTrafficLogging(),
 		WithDefaultLogger(),
+		WithDefaultRegionCache(),
 	}
 	for _, opt := range defaultOptions {
 		opt(c)
@@ -258,6 +262,14 @@ func WithDefaultLogger() Option {
 	}
 }
 
+func WithDefaultRegionCache() Option {
+	return func(c *Client) {
+		if c.regions == nil {
+			c.regions = &regionCache{}
+		}
+	}
+}
+
 func WithDefaultTraceID() Option {
 	return func(c *Client) {
 		if c.traceId == "" {
@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    zillizcloud = {
+      source = "zilliztech/zillizcloud"
+    }
+  }
+}
+
+provider "zillizcloud" {
+}
+
+data "zillizcloud_available_versions" "aws_us_west_2" {
+  region_id = "aws-us-west-2"
+}
+
+output "versions" {
+  value = data.zillizcloud_available_versions.aws_us_west_2.versions
+}
+
+output "default_version" {
+  value = data.zillizcloud_available_versions.aws_us_west_2.default_version
+}
@@ -0,0 +1,46 @@
+terraform {
+  required_providers {
+    zillizcloud = {
+      source = "zilliztech/zillizcloud"
+    }
+  }
+}
+
+provider "zillizcloud" {
+}
+
+data "zillizcloud_project" "default" {
+  # Fetching the default project information to be used in cluster provisioning
+}
+
+resource "zillizcloud_cluster" "example" {
+  cluster_name = "Cluster-03"                        # The name of the cluster
+  region_id    = "aws-us-east-2"                     # The region where the cluster will be deployed
+  plan         = "Enterprise"                        # The service plan for the cluster
+  cu_size      = "1"                                 # The size of the compute unit
+  cu_type      = "Performance-optimized"             # The type of compute unit, optimized for performance
+  project_id   = data.zillizcloud_project.default.id # Linking to the project ID fetched earlier
+}
+
+# A collection that is managed by another team.
+data "zillizcloud_collection" "shared" {
+  connect_address = zillizcloud_cluster.example.connect_address
+  db_name         = "shared"
+  collection_name = "documents"
+}
+
+locals {
+  vector_field = one([for f in data.zillizcloud_collection.shared.fields : f if f.dim != null])
+}
+
+output "vector_field_name" {
+  value = local.vector_field.field_name
+}
+
+output "vector_dim" {
+  value = local.vector_field.dim
+}
+
+output "row_count" {
+  value = data.zillizcloud_collection.shared.row_count
+}
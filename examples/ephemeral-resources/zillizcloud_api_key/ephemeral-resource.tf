@@ -0,0 +1,33 @@
+terraform {
+  required_providers {
+    zillizcloud = {
+      source = "zilliztech/zillizcloud"
+    }
+  }
+}
+
+provider "zillizcloud" {
+}
+
+data "zillizcloud_project" "default" {
+}
+
+# A project-scoped key that only exists for the duration of the run.
+ephemeral "zillizcloud_api_key" "scoped" {
+  name = "terraform-run"
+  role = "Member"
+
+  project_access = [{
+    project_id = data.zillizcloud_project.default.id
+    role       = "Read-Write"
+  }]
+}
+
+provider "zillizcloud" {
+  alias   = "scoped"
+  api_key = ephemeral.zillizcloud_api_key.scoped.key_value
+}
+
+data "zillizcloud_clusters" "scoped" {
+  provider = zillizcloud.scoped
+}
@@ -0,0 +1,35 @@
+terraform {
+  required_providers {
+    zillizcloud = {
+      source = "zilliztech/zillizcloud"
+    }
+  }
+}
+
+provider "zillizcloud" {
+}
+
+variable "connect_address" {
+  type = string
+}
+
+# A temporary read-write user, dropped again at the end of the run.
+ephemeral "zillizcloud_cluster_credentials" "loader" {
+  connect_address = var.connect_address
+  username_prefix = "ci_"
+  roles           = ["db_rw"]
+}
+
+# Provisioners accept ephemeral values, so the credentials are only used while
+# the data is loaded and never end up in the plan or state.
+resource "terraform_data" "seed" {
+  triggers_replace = [var.connect_address]
+
+  provisioner "local-exec" {
+    command = "python3 seed.py"
+    environment = {
+      MILVUS_URI   = var.connect_address
+      MILVUS_TOKEN = "${ephemeral.zillizcloud_cluster_credentials.loader.username}:${ephemeral.zillizcloud_cluster_credentials.loader.password}"
+    }
+  }
+}
@@ -80,3 +80,21 @@ resource "zillizcloud_cluster" "full_autoscaling_cluster" {
     }
   }
 }
+
+# Replace the generated root password with a write-only value that is never
+# stored in state (Terraform 1.11+). Bump the version to rotate it.
+variable "root_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "zillizcloud_cluster" "write_only_password_cluster" {
+  cluster_name             = "Cluster-07"
+  region_id                = "aws-us-east-2"
+  plan                     = "Enterprise"
+  cu_size                  = 1
+  cu_type                  = "Performance-optimized"
+  project_id               = data.zillizcloud_project.default.id
+  root_password_wo         = var.root_password
+  root_password_wo_version = 1
+}
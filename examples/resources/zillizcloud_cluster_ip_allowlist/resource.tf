@@ -0,0 +1,46 @@
+terraform {
+  required_providers {
+    zillizcloud = {
+      source = "zilliztech/zillizcloud"
+    }
+  }
+}
+
+# Configure the Zilliz Cloud provider
+provider "zillizcloud" {
+}
+
+# Fetch project information
+data "zillizcloud_project" "default" {
+}
+
+resource "zillizcloud_cluster" "example" {
+  cluster_name = "example-cluster"
+  project_id   = data.zillizcloud_project.default.id
+  plan         = "Standard"
+  cu_size      = 1
+  cu_type      = "Performance-optimized"
+}
+
+# Only allow the NAT gateway egress IPs and the office network to reach the public endpoint
+resource "zillizcloud_cluster_ip_allowlist" "example" {
+  cluster_id = zillizcloud_cluster.example.id
+
+  entries = [
+    {
+      cidr        = "203.0.113.10/32" # Replace with your NAT gateway egress IP
+      description = "nat-gateway-a"
+    },
+    {
+      cidr        = "203.0.113.11/32"
+      description = "nat-gateway-b"
+    },
+    {
+      cidr        = "198.51.100.0/24"
+      description = "office"
+    },
+  ]
+}
+
+# The allowlist can be imported with the cluster ID:
+# terraform import zillizcloud_cluster_ip_allowlist.example in01-xxxxxxxxxxxxxxx
@@ -37,6 +37,7 @@ resource "zillizcloud_collection" "mycollection" {
   connect_address = zillizcloud_cluster.mycluster.connect_address
   db_name         = zillizcloud_database.mydb.db_name
   collection_name = "mycollection"
+  description     = "Product embeddings"
   schema = {
     auto_id               = true
     enabled_dynamic_field = false
@@ -53,6 +54,14 @@ resource "zillizcloud_collection" "mycollection" {
           dim = "128"
         }
       },
+      {
+        field_name       = "tenant"
+        data_type        = "VarChar"
+        is_partition_key = true
+        element_type_params = {
+          max_length = "64"
+        }
+      },
       {
         field_name        = "tags"
         data_type         = "Array"
@@ -68,5 +77,15 @@ resource "zillizcloud_collection" "mycollection" {
     "mmap_enabled"      = true
     "ttl_seconds"       = 86400
     "consistency_level" = "Bounded"
+    "shards_num"        = 2
+    "num_partitions"    = 32
+  }
+  properties = {
+    "collection.autocompaction.enabled" = "true"
+  }
+  field_properties = {
+    "vector" = {
+      "mmap.enabled" = "false"
+    }
   }
 }
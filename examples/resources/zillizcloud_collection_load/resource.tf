@@ -0,0 +1,57 @@
+terraform {
+  required_providers {
+    zillizcloud = {
+      source = "zilliztech/zillizcloud"
+    }
+  }
+}
+
+provider "zillizcloud" {
+}
+
+variable "connect_address" {
+  type = string
+}
+
+resource "zillizcloud_collection" "mycollection" {
+  connect_address = var.connect_address
+  db_name         = "default"
+  collection_name = "mycollection"
+  schema = {
+    auto_id               = true
+    enabled_dynamic_field = false
+    fields = [
+      {
+        field_name = "id"
+        data_type  = "Int64"
+        is_primary = true
+      },
+      {
+        field_name = "vector"
+        data_type  = "FloatVector"
+        element_type_params = {
+          dim = "128"
+        }
+      }
+    ]
+  }
+}
+
+resource "zillizcloud_index" "myindex" {
+  connect_address = var.connect_address
+  db_name         = zillizcloud_collection.mycollection.db_name
+  collection_name = zillizcloud_collection.mycollection.collection_name
+  field_name      = "vector"
+  metric_type     = "IP"
+  index_name      = "vector_index"
+  index_type      = "HNSW"
+}
+
+# Milvus only loads an indexed collection, so the load waits for the index.
+resource "zillizcloud_collection_load" "mycollection" {
+  connect_address = var.connect_address
+  db_name         = zillizcloud_collection.mycollection.db_name
+  collection_name = zillizcloud_collection.mycollection.collection_name
+
+  depends_on = [zillizcloud_index.myindex]
+}
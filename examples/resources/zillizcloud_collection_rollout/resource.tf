@@ -0,0 +1,66 @@
+terraform {
+  required_providers {
+    zillizcloud = {
+      source = "zilliztech/zillizcloud"
+    }
+  }
+}
+
+provider "zillizcloud" {
+}
+
+data "zillizcloud_project" "default" {
+  # Fetching the default project information to be used in cluster provisioning
+}
+
+resource "zillizcloud_cluster" "mycluster" {
+  cluster_name = "Cluster-03"                        # The name of the cluster
+  region_id    = "aws-us-east-2"                     # The region where the cluster will be deployed
+  plan         = "Enterprise"                        # The service plan for the cluster
+  cu_size      = "1"                                 # The size of the compute unit
+  cu_type      = "Performance-optimized"             # The type of compute unit, optimized for performance
+  project_id   = data.zillizcloud_project.default.id # Linking to the project ID fetched earlier
+}
+
+resource "zillizcloud_database" "mydb" {
+  connect_address = zillizcloud_cluster.mycluster.connect_address
+  db_name         = "mydb"
+}
+
+# Clients query the "products" alias, which points at products_v1.
+# To roll out a new schema, set version = "v2" and active_version = "v1": products_v2 is created
+# while traffic stays on products_v1. Once products_v2 is backfilled, remove active_version to move
+# the alias to products_v2 in one step; products_v1 is released and kept for rollback.
+resource "zillizcloud_collection_rollout" "products" {
+  connect_address = zillizcloud_cluster.mycluster.connect_address
+  db_name         = zillizcloud_database.mydb.db_name
+  alias_name      = "products"
+  version         = "v1"
+  retain_versions = 1
+
+  schema = {
+    fields = [
+      {
+        field_name = "id"
+        data_type  = "Int64"
+        is_primary = true
+      },
+      {
+        field_name = "vector"
+        data_type  = "FloatVector"
+        element_type_params = {
+          dim = "768"
+        }
+      }
+    ]
+  }
+
+  indexes = [
+    {
+      field_name  = "vector"
+      index_name  = "vector_idx"
+      index_type  = "AUTOINDEX"
+      metric_type = "COSINE"
+    }
+  ]
+}
@@ -25,10 +25,14 @@ resource "zillizcloud_cluster" "cluster" {
 resource "zillizcloud_database" "db" {
   connect_address = zillizcloud_cluster.cluster.connect_address
   db_name         = "db"
+
+  replica_number     = 1
+  max_collections    = 10
+  force_deny_writing = false
+  force_deny_reading = false
+
+  # Properties without a typed attribute can still be set here.
   properties = {
-    "database.replica.number"     = "1"
-    "database.max.collections"    = "10"
-    "database.force.deny.writing" = "false"
-    "database.force.deny.reading" = "false"
+    "database.diskQuota.mb" = "1024"
   }
 }
@@ -71,3 +71,12 @@ resource "zillizcloud_index" "myindex" {
   index_name      = "testindex"
   index_type      = "HNSW"
 }
+
+# Loaded once the index exists, so the collection is searchable after a single apply.
+resource "zillizcloud_collection_load" "mycollection" {
+  connect_address = zillizcloud_cluster.mycluster.connect_address
+  db_name         = zillizcloud_database.mydb.db_name
+  collection_name = zillizcloud_collection.mycollection.collection_name
+
+  depends_on = [zillizcloud_index.myindex]
+}
@@ -0,0 +1,41 @@
+terraform {
+  required_providers {
+    zillizcloud = {
+      source = "zilliztech/zillizcloud"
+    }
+  }
+}
+
+provider "zillizcloud" {
+}
+
+data "zillizcloud_project" "default" {
+  # Fetching the default project information to be used in cluster provisioning
+}
+
+resource "zillizcloud_cluster" "cluster" {
+  cluster_name = "Cluster-03"                        # The name of the cluster
+  region_id    = "aws-us-east-2"                     # The region where the cluster will be deployed
+  plan         = "Enterprise"                        # The service plan for the cluster
+  cu_size      = "1"                                 # The size of the compute unit
+  cu_type      = "Performance-optimized"             # The type of compute unit, optimized for performance
+  project_id   = data.zillizcloud_project.default.id # Linking to the project ID fetched earlier
+}
+
+resource "zillizcloud_privilege_group" "readers" {
+  connect_address      = zillizcloud_cluster.cluster.connect_address
+  privilege_group_name = "team_readers"
+  privileges           = ["Search", "Query", "DescribeCollection"]
+}
+
+resource "zillizcloud_role" "search_team" {
+  connect_address = zillizcloud_cluster.cluster.connect_address
+  role_name       = "search_team"
+  privilege_groups = [
+    {
+      privilege_group = zillizcloud_privilege_group.readers.privilege_group_name
+      db_name         = "mydb"
+      collection_name = "*"
+    }
+  ]
+}
@@ -0,0 +1,40 @@
+terraform {
+  required_providers {
+    zillizcloud = {
+      source = "zilliztech/zillizcloud"
+    }
+  }
+}
+
+provider "zillizcloud" {
+}
+
+data "zillizcloud_project" "default" {
+  # Fetching the default project information to be used in cluster provisioning
+}
+
+resource "zillizcloud_cluster" "cluster" {
+  cluster_name = "Cluster-03"                        # The name of the cluster
+  region_id    = "aws-us-east-2"                     # The region where the cluster will be deployed
+  plan         = "Enterprise"                        # The service plan for the cluster
+  cu_size      = "4"                                 # The size of the compute unit
+  cu_type      = "Performance-optimized"             # The type of compute unit, optimized for performance
+  project_id   = data.zillizcloud_project.default.id # Linking to the project ID fetched earlier
+}
+
+resource "zillizcloud_resource_group" "tenant_a" {
+  connect_address   = zillizcloud_cluster.cluster.connect_address
+  name              = "rg_tenant_a"
+  requests_node_num = 1
+  limits_node_num   = 2
+  transfer_from     = ["__default_resource_group"]
+  transfer_to       = ["__default_resource_group"]
+}
+
+# Load the tenant's collections on the query nodes of its resource group only.
+resource "zillizcloud_database" "tenant_a" {
+  connect_address = zillizcloud_cluster.cluster.connect_address
+  db_name         = "tenant_a"
+  resource_groups = [zillizcloud_resource_group.tenant_a.name]
+  replica_number  = 1
+}
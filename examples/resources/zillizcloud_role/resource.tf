@@ -0,0 +1,55 @@
+terraform {
+  required_providers {
+    zillizcloud = {
+      source = "zilliztech/zillizcloud"
+    }
+  }
+}
+
+provider "zillizcloud" {
+}
+
+data "zillizcloud_project" "default" {
+  # Fetching the default project information to be used in cluster provisioning
+}
+
+resource "zillizcloud_cluster" "cluster" {
+  cluster_name = "Cluster-03"                        # The name of the cluster
+  region_id    = "aws-us-east-2"                     # The region where the cluster will be deployed
+  plan         = "Enterprise"                        # The service plan for the cluster
+  cu_size      = "1"                                 # The size of the compute unit
+  cu_type      = "Performance-optimized"             # The type of compute unit, optimized for performance
+  project_id   = data.zillizcloud_project.default.id # Linking to the project ID fetched earlier
+}
+
+resource "zillizcloud_role" "search_team" {
+  connect_address = zillizcloud_cluster.cluster.connect_address
+  role_name       = "search_team"
+  privileges = [
+    {
+      object_type = "Collection"
+      object_name = "*"
+      db_name     = "mydb"
+      privilege   = "Search"
+    },
+    {
+      object_type = "Collection"
+      object_name = "*"
+      db_name     = "mydb"
+      privilege   = "Query"
+    }
+  ]
+  privilege_groups = [
+    {
+      privilege_group = "COLL_RO" # built-in group, see the zillizcloud_privilege_groups data source
+      db_name         = "mydb"
+      collection_name = "*"
+    }
+  ]
+}
+
+resource "zillizcloud_user_role" "search_user_role" {
+  connect_address = zillizcloud_cluster.cluster.connect_address
+  username        = "search_user"
+  roles           = [zillizcloud_role.search_team.role_name]
+}
@@ -33,3 +33,18 @@ resource "zillizcloud_user" "myuser" {
   username        = "test111"
   password        = random_password.password.result
 }
+
+# Keep the password out of state with a write-only attribute (Terraform 1.11+).
+# Bump password_wo_version to rotate the password; role grants are preserved.
+ephemeral "random_password" "app_password" {
+  length           = 16
+  special          = true
+  override_special = "!#$%&*()-_=+[]{}<>:?"
+}
+
+resource "zillizcloud_user" "app_user" {
+  connect_address     = zillizcloud_cluster.cluster.connect_address
+  username            = "app_user"
+  password_wo         = ephemeral.random_password.app_password.result
+  password_wo_version = 1
+}
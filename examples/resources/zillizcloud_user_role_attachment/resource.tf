@@ -0,0 +1,34 @@
+terraform {
+  required_providers {
+    zillizcloud = {
+      source = "zilliztech/zillizcloud"
+    }
+  }
+}
+
+provider "zillizcloud" {
+}
+
+variable "connect_address" {
+  type = string
+}
+
+resource "zillizcloud_user" "myuser" {
+  connect_address = var.connect_address
+  username        = "app_user"
+  password        = "LZ0lS#FRU5V49$2q"
+}
+
+# Each attachment manages one role only, so roles granted elsewhere
+# (for example by another module) are left untouched.
+resource "zillizcloud_user_role_attachment" "read" {
+  connect_address = var.connect_address
+  username        = zillizcloud_user.myuser.username
+  role_name       = "db_ro"
+}
+
+resource "zillizcloud_user_role_attachment" "write" {
+  connect_address = var.connect_address
+  username        = zillizcloud_user.myuser.username
+  role_name       = "db_rw"
+}
@@ -0,0 +1,137 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+)
+
+// clusterTypeOfPlan maps a plan to the cluster type reported in a region's
+// supportedClusterTypes.
+func clusterTypeOfPlan(plan string) string {
+	switch plan {
+	case FreePlan:
+		return "free"
+	case ServerlessPlan:
+		return "serverless"
+	default:
+		return "dedicated"
+	}
+}
+
+func (c *ClusterResourceModel) isShapeChanged(other ClusterResourceModel) bool {
+	return c.isPlanChanged(other) || c.isCuTypeChanged(other) ||
+		(isKnownInt64(c.CuSize) && c.isCuSizeChanged(other)) ||
+		(isKnownInt64(c.Replica) && c.isReplicaChanged(other))
+}
+
+// validateRegionCapabilities checks the planned cluster shape against what
+// the region accepts. Unknown values and regions without capability metadata
+// are not checked, the API remains the final authority for those.
+func validateRegionCapabilities(plan ClusterResourceModel, region *zilliz.CloudRegion) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if region == nil || plan.Plan.IsUnknown() || plan.Plan.IsNull() {
+		return diags
+	}
+
+	planName := plan.Plan.ValueString()
+	clusterType := clusterTypeOfPlan(planName)
+	supported := slices.ContainsFunc(region.SupportedClusterTypes, func(t string) bool {
+		return strings.EqualFold(t, clusterType)
+	})
+	if len(region.SupportedClusterTypes) > 0 && !supported {
+		diags.AddAttributeError(path.Root("plan"), "Plan not available in region",
+			fmt.Sprintf("Region %s does not support %s clusters. Supported cluster types: %s.",
+				region.RegionId, clusterType, strings.Join(region.SupportedClusterTypes, ", ")))
+		return diags
+	}
+
+	caps := region.Capabilities
+	if caps == nil || clusterType != "dedicated" {
+		return diags
+	}
+
+	if len(caps.Plans) > 0 && !slices.Contains(caps.Plans, planName) {
+		diags.AddAttributeError(path.Root("plan"), "Plan not available in region",
+			fmt.Sprintf("Region %s does not support the %s plan. Supported plans: %s.",
+				region.RegionId, planName, strings.Join(caps.Plans, ", ")))
+	}
+
+	if plan.CuType.IsUnknown() || plan.CuType.IsNull() || len(caps.CuTypes) == 0 {
+		return diags
+	}
+	cuType := plan.CuType.ValueString()
+	cuTypeCaps := caps.CuType(cuType)
+	if cuTypeCaps == nil {
+		available := make([]string, 0, len(caps.CuTypes))
+		for _, c := range caps.CuTypes {
+			available = append(available, c.CuType)
+		}
+		diags.AddAttributeError(path.Root("cu_type"), "CU type not available in region",
+			fmt.Sprintf("Region %s does not support cu_type %s. Supported cu_type values: %s.",
+				region.RegionId, cuType, strings.Join(available, ", ")))
+		return diags
+	}
+
+	if len(cuTypeCaps.Plans) > 0 && !slices.Contains(cuTypeCaps.Plans, planName) {
+		diags.AddAttributeError(path.Root("cu_type"), "CU type not available for plan",
+			fmt.Sprintf("cu_type %s in region %s requires one of the plans: %s.",
+				cuType, region.RegionId, strings.Join(cuTypeCaps.Plans, ", ")))
+	}
+
+	if isKnownInt64(plan.CuSize) && len(cuTypeCaps.CuSizes) > 0 && !slices.Contains(cuTypeCaps.CuSizes, plan.CuSize.ValueInt64()) {
+		sizes := make([]string, 0, len(cuTypeCaps.CuSizes))
+		for _, size := range cuTypeCaps.CuSizes {
+			sizes = append(sizes, fmt.Sprint(size))
+		}
+		diags.AddAttributeError(path.Root("cu_size"), "CU size not available",
+			fmt.Sprintf("cu_size %d is not available for %s in region %s. Supported sizes: %s.",
+				plan.CuSize.ValueInt64(), cuType, region.RegionId, strings.Join(sizes, ", ")))
+	}
+
+	if isKnownInt64(plan.Replica) && cuTypeCaps.MaxReplica > 0 && plan.Replica.ValueInt64() > cuTypeCaps.MaxReplica {
+		diags.AddAttributeError(path.Root("replica"), "Replica count not available",
+			fmt.Sprintf("replica %d exceeds the maximum of %d for %s in region %s.",
+				plan.Replica.ValueInt64(), cuTypeCaps.MaxReplica, cuType, region.RegionId))
+	}
+
+	return diags
+}
+
+// describeRegion looks up the planned region through the provider client,
+// whose region list is cached per provider instance. Lookup failures are
+// logged and skip the capability checks rather than blocking the plan.
+func (r *ClusterResource) describeRegion(ctx context.Context, plan ClusterResourceModel) *zilliz.CloudRegion {
+	regionId := r.plannedRegionId(plan)
+	if regionId == "" {
+		return nil
+	}
+
+	region, err := r.client.DescribeCloudRegion(regionId)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to describe cloud region, skipping capability checks", map[string]interface{}{
+			"region_id": regionId,
+			"error":     err.Error(),
+		})
+		return nil
+	}
+	return region
+}
+
+// plannedRegionId returns the region the cluster is planned in, falling back to
+// the provider region. It is empty when the region is not known yet.
+func (r *ClusterResource) plannedRegionId(plan ClusterResourceModel) string {
+	if r.client == nil || plan.RegionId.IsUnknown() {
+		return ""
+	}
+	if plan.RegionId.ValueString() != "" {
+		return plan.RegionId.ValueString()
+	}
+	return r.client.RegionId
+}
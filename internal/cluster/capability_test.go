@@ -0,0 +1,142 @@
+package cluster
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+)
+
+func capabilityModel(plan, cuType string, cuSize, replica int64) ClusterResourceModel {
+	return ClusterResourceModel{
+		RegionId: types.StringValue("aws-us-west-2"),
+		Plan:     types.StringValue(plan),
+		CuType:   types.StringValue(cuType),
+		CuSize:   types.Int64Value(cuSize),
+		Replica:  types.Int64Value(replica),
+	}
+}
+
+func TestValidateRegionCapabilities(t *testing.T) {
+	region := &zilliz.CloudRegion{
+		RegionId:              "aws-us-west-2",
+		SupportedClusterTypes: []string{"Serverless", "Dedicated"},
+		Capabilities: &zilliz.RegionCapabilities{
+			Plans: []string{StandardPlan, EnterprisePlan},
+			CuTypes: []zilliz.CuTypeCapability{
+				{CuType: "Performance-optimized", CuSizes: []int64{1, 2, 4, 8}, MaxReplica: 4},
+				{CuType: "Capacity-optimized", CuSizes: []int64{4, 8}, Plans: []string{EnterprisePlan}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		plan      ClusterResourceModel
+		region    *zilliz.CloudRegion
+		wantPaths []path.Path
+	}{
+		{
+			name:   "supported shape",
+			plan:   capabilityModel(EnterprisePlan, "Capacity-optimized", 8, 1),
+			region: region,
+		},
+		{
+			name:   "region without metadata",
+			plan:   capabilityModel(BusinessCriticalPlan, "Tiered-storage", 3, 9),
+			region: nil,
+		},
+		{
+			name:      "free clusters not offered",
+			plan:      capabilityModel(FreePlan, "Performance-optimized", 1, 1),
+			region:    region,
+			wantPaths: []path.Path{path.Root("plan")},
+		},
+		{
+			name:      "plan not offered",
+			plan:      capabilityModel(BusinessCriticalPlan, "Performance-optimized", 8, 1),
+			region:    region,
+			wantPaths: []path.Path{path.Root("plan")},
+		},
+		{
+			name:      "cu_type not offered",
+			plan:      capabilityModel(EnterprisePlan, "Tiered-storage", 8, 1),
+			region:    region,
+			wantPaths: []path.Path{path.Root("cu_type")},
+		},
+		{
+			name:      "cu_type needs another plan",
+			plan:      capabilityModel(StandardPlan, "Capacity-optimized", 8, 1),
+			region:    region,
+			wantPaths: []path.Path{path.Root("cu_type")},
+		},
+		{
+			name:      "cu_size not offered",
+			plan:      capabilityModel(EnterprisePlan, "Capacity-optimized", 2, 1),
+			region:    region,
+			wantPaths: []path.Path{path.Root("cu_size")},
+		},
+		{
+			name:      "too many replicas",
+			plan:      capabilityModel(EnterprisePlan, "Performance-optimized", 8, 5),
+			region:    region,
+			wantPaths: []path.Path{path.Root("replica")},
+		},
+		{
+			name: "unknown cu_size is skipped",
+			plan: ClusterResourceModel{
+				Plan:    types.StringValue(EnterprisePlan),
+				CuType:  types.StringValue("Capacity-optimized"),
+				CuSize:  types.Int64Unknown(),
+				Replica: types.Int64Value(1),
+			},
+			region: region,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateRegionCapabilities(tt.plan, tt.region)
+			if len(diags) != len(tt.wantPaths) {
+				t.Fatalf("diagnostics = %s, want errors on %v", diags, tt.wantPaths)
+			}
+			for i, d := range diags {
+				withPath, ok := d.(interface{ Path() path.Path })
+				if !ok || !withPath.Path().Equal(tt.wantPaths[i]) {
+					t.Fatalf("diagnostic %d = %s, want attribute error on %s", i, d.Summary(), tt.wantPaths[i])
+				}
+			}
+		})
+	}
+}
+
+func TestDescribeRegionIsCachedPerProvider(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"code":0,"data":[{"cloudId":"aws","regionId":"aws-us-west-2","supportedClusterTypes":["dedicated"]}]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := zilliz.NewClient(zilliz.WithApiKey("test-api-key"), zilliz.WithBaseUrl(server.URL))
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	r := &ClusterResource{client: client}
+
+	plan := ClusterResourceModel{RegionId: types.StringValue("aws-us-west-2")}
+	for i := 0; i < 3; i++ {
+		region := r.describeRegion(context.Background(), plan)
+		if region == nil || region.RegionId != "aws-us-west-2" {
+			t.Fatalf("region = %+v, want aws-us-west-2", region)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("regions listed %d times, want 1", calls)
+	}
+}
@@ -0,0 +1,246 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+	customvalidator "github.com/zilliztech/terraform-provider-zillizcloud/internal/validator"
+)
+
+var _ resource.Resource = &ClusterIpAllowlistResource{}
+var _ resource.ResourceWithConfigure = &ClusterIpAllowlistResource{}
+var _ resource.ResourceWithImportState = &ClusterIpAllowlistResource{}
+var _ resource.ResourceWithValidateConfig = &ClusterIpAllowlistResource{}
+
+func NewClusterIpAllowlistResource() resource.Resource {
+	return &ClusterIpAllowlistResource{}
+}
+
+type ClusterIpAllowlistResource struct {
+	client *zilliz.Client
+}
+
+type ClusterIpAllowlistResourceModel struct {
+	Id        types.String       `tfsdk:"id"`
+	ClusterId types.String       `tfsdk:"cluster_id"`
+	Entries   []IpAllowlistEntry `tfsdk:"entries"`
+}
+
+type IpAllowlistEntry struct {
+	Cidr        types.String `tfsdk:"cidr"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (r *ClusterIpAllowlistResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_ip_allowlist"
+}
+
+func (r *ClusterIpAllowlistResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages the IP allowlist of a Zilliz Cloud cluster.
+
+Only the listed CIDR blocks can reach the public endpoint of the cluster, ` + "`connect_address`" + `. The resource is authoritative: entries added outside Terraform are removed on the next apply, and destroying the resource clears the allowlist.
+
+Typical use case: Restrict production clusters to the egress IPs of your NAT gateways. To close the public endpoint entirely, set ` + "`public_endpoint_enabled = false`" + ` on the ` + "`zillizcloud_cluster`" + ` resource instead.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: `The ID of the cluster IP allowlist, which is the cluster ID.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: `The ID of the cluster whose public endpoint is restricted.
+
+You can obtain this value from the output of the ` + "`zillizcloud_cluster`" + ` resource, for example:
+` + "`zillizcloud_cluster.example.id`" + `
+
+> **Note:** Changing this value will force recreation of the resource.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entries": schema.SetNestedAttribute{
+				Required:            true,
+				MarkdownDescription: `The CIDR blocks allowed to reach the cluster. Each CIDR block can only be listed once.`,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cidr": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: `The CIDR block to allow, such as ` + "`203.0.113.0/24`" + `. Use a /32 block for a single address.`,
+							Validators: []validator.String{
+								customvalidator.CIDRValidator{},
+							},
+						},
+						"description": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(""),
+							MarkdownDescription: `A description of the entry, such as the network it belongs to.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ClusterIpAllowlistResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*zilliz.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *zilliz.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ClusterIpAllowlistResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var entries types.Set
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("entries"), &entries)...)
+	if resp.Diagnostics.HasError() || entries.IsNull() || entries.IsUnknown() {
+		return
+	}
+
+	// Unknown entries are skipped, Terraform validates the configuration again once they are known.
+	seen := make(map[string]bool, len(entries.Elements()))
+	for _, elem := range entries.Elements() {
+		entry, ok := elem.(types.Object)
+		if !ok || entry.IsNull() || entry.IsUnknown() {
+			continue
+		}
+		cidr, ok := entry.Attributes()["cidr"].(types.String)
+		if !ok || cidr.IsNull() || cidr.IsUnknown() {
+			continue
+		}
+		if seen[cidr.ValueString()] {
+			resp.Diagnostics.AddAttributeError(path.Root("entries"), "Duplicate CIDR block",
+				fmt.Sprintf("%s is listed more than once, each CIDR block can only be listed once.", cidr.ValueString()))
+		}
+		seen[cidr.ValueString()] = true
+	}
+}
+
+func (r *ClusterIpAllowlistResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ClusterIpAllowlistResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpsertIpAllowlist(data.ClusterId.ValueString(), &zilliz.UpsertIpAllowlistParams{
+		Entries: data.toAPIEntries(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create cluster IP allowlist", err.Error())
+		return
+	}
+
+	// Set ID to cluster_id
+	data.Id = data.ClusterId
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterIpAllowlistResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ClusterIpAllowlistResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries, err := r.client.GetIpAllowlist(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read cluster IP allowlist", err.Error())
+		return
+	}
+
+	state.Entries = make([]IpAllowlistEntry, len(entries))
+	for i, entry := range entries {
+		state.Entries[i] = IpAllowlistEntry{
+			Cidr:        types.StringValue(entry.Cidr),
+			Description: types.StringValue(entry.Description),
+		}
+	}
+
+	state.ClusterId = state.Id
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ClusterIpAllowlistResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ClusterIpAllowlistResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpsertIpAllowlist(plan.ClusterId.ValueString(), &zilliz.UpsertIpAllowlistParams{
+		Entries: plan.toAPIEntries(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update cluster IP allowlist", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ClusterIpAllowlistResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ClusterIpAllowlistResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Clear the allowlist by passing an empty array
+	_, err := r.client.UpsertIpAllowlist(data.ClusterId.ValueString(), &zilliz.UpsertIpAllowlistParams{
+		Entries: []zilliz.IpAllowlistEntry{},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to delete cluster IP allowlist", err.Error())
+		return
+	}
+}
+
+func (r *ClusterIpAllowlistResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (m *ClusterIpAllowlistResourceModel) toAPIEntries() []zilliz.IpAllowlistEntry {
+	entries := make([]zilliz.IpAllowlistEntry, len(m.Entries))
+	for i, entry := range m.Entries {
+		entries[i] = zilliz.IpAllowlistEntry{
+			Cidr:        entry.Cidr.ValueString(),
+			Description: entry.Description.ValueString(),
+		}
+	}
+	return entries
+}
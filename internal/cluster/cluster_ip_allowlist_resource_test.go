@@ -0,0 +1,91 @@
+package cluster_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/zilliztech/terraform-provider-zillizcloud/internal/provider"
+)
+
+func TestAccClusterIpAllowlistResource(t *testing.T) {
+	config := func(entries string) string {
+		return provider.ProviderConfig + fmt.Sprintf(`
+data "zillizcloud_project" "default" {
+  id = "proj-test123456789"
+}
+
+resource "zillizcloud_cluster" "test" {
+  cluster_name = "test-cluster-ip-allowlist"
+  project_id   = data.zillizcloud_project.default.id
+  plan         = "Standard"
+  cu_size      = 1
+  cu_type      = "Performance-optimized"
+}
+
+resource "zillizcloud_cluster_ip_allowlist" "test" {
+  cluster_id = zillizcloud_cluster.test.id
+
+  entries = [%s]
+}
+`, entries)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config(`
+    { cidr = "203.0.113.10/32", description = "nat-gateway" },
+    { cidr = "198.51.100.0/24" },
+  `),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("zillizcloud_cluster_ip_allowlist.test", "cluster_id", "zillizcloud_cluster.test", "id"),
+					resource.TestCheckResourceAttr("zillizcloud_cluster_ip_allowlist.test", "entries.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("zillizcloud_cluster_ip_allowlist.test", "entries.*", map[string]string{
+						"cidr":        "203.0.113.10/32",
+						"description": "nat-gateway",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("zillizcloud_cluster_ip_allowlist.test", "entries.*", map[string]string{
+						"cidr":        "198.51.100.0/24",
+						"description": "",
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "zillizcloud_cluster_ip_allowlist.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: config(`
+    { cidr = "203.0.113.11/32", description = "nat-gateway" },
+  `),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zillizcloud_cluster_ip_allowlist.test", "entries.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("zillizcloud_cluster_ip_allowlist.test", "entries.*", map[string]string{
+						"cidr": "203.0.113.11/32",
+					}),
+				),
+			},
+			// Invalid and duplicate blocks are rejected at plan time
+			{
+				Config:      config(`{ cidr = "203.0.113.11/24" }`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`use 203.0.113.0/24 instead`),
+			},
+			{
+				Config: config(`
+    { cidr = "203.0.113.11/32", description = "nat-gateway" },
+    { cidr = "203.0.113.11/32", description = "nat-gateway-b" },
+  `),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Duplicate CIDR block`),
+			},
+		},
+	})
+}
@@ -0,0 +1,151 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+	util "github.com/zilliztech/terraform-provider-zillizcloud/client/retry"
+)
+
+const defaultClusterReadyTimeout = "45m"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ClusterReadyDataSource{}
+
+func NewClusterReadyDataSource() datasource.DataSource {
+	return &ClusterReadyDataSource{}
+}
+
+// ClusterReadyDataSource blocks until a cluster reaches RUNNING, so resources
+// that talk to the data plane can depend on it while the cluster itself is
+// created with wait_for_ready = false.
+type ClusterReadyDataSource struct {
+	client *zilliz.Client
+}
+
+// ClusterReadyDataSourceModel describes the cluster ready data model.
+type ClusterReadyDataSourceModel struct {
+	ClusterId          types.String `tfsdk:"cluster_id"`
+	Timeout            types.String `tfsdk:"timeout"`
+	Status             types.String `tfsdk:"status"`
+	ConnectAddress     types.String `tfsdk:"connect_address"`
+	PrivateLinkAddress types.String `tfsdk:"private_link_address"`
+}
+
+func (d *ClusterReadyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_ready"
+}
+
+func (d *ClusterReadyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Waits for a cluster to reach RUNNING. Use it together with `wait_for_ready = false` on `zillizcloud_cluster`, so that only the resources that need a running cluster wait for it. When the cluster ID is only known after apply, the wait happens during apply.\n\nA suspended cluster fails the read right away, unless it has a `suspend_schedule`: then the data source waits for the schedule to resume it, and plans run while the cluster is suspended fail once `timeout` expires.",
+
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the cluster to wait for.",
+				Required:            true,
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to wait for the cluster to reach RUNNING. Accepts a string that can be [parsed as a duration](https://pkg.go.dev/time#ParseDuration), such as \"30s\" or \"2h45m\". Defaults to 45m.",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the cluster, always RUNNING once the data source has been read.",
+				Computed:            true,
+			},
+			"connect_address": schema.StringAttribute{
+				MarkdownDescription: "The public endpoint of the cluster.",
+				Computed:            true,
+			},
+			"private_link_address": schema.StringAttribute{
+				MarkdownDescription: "The private endpoint of the cluster.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ClusterReadyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*zilliz.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zilliz.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ClusterReadyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ClusterReadyDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeoutValue := defaultClusterReadyTimeout
+	if !state.Timeout.IsNull() {
+		timeoutValue = state.Timeout.ValueString()
+	}
+	timeout, err := time.ParseDuration(timeoutValue)
+	if err != nil || timeout <= 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("timeout"), "Invalid timeout", fmt.Sprintf("%q is not a positive duration, use a value such as \"30m\" or \"2h\".", timeoutValue))
+		return
+	}
+
+	clusterId := state.ClusterId.ValueString()
+	tflog.Info(ctx, "Waiting for cluster to be RUNNING", map[string]interface{}{
+		"cluster_id": clusterId,
+		"timeout":    timeout.String(),
+	})
+
+	cluster, err := util.NetworkResilientPoll(ctx, timeout, func() (*zilliz.Cluster, *util.Err) {
+		cluster, err := d.client.DescribeCluster(clusterId)
+		if err != nil {
+			return nil, &util.Err{Err: err, Halt: false}
+		}
+		switch cluster.Status {
+		case "RUNNING":
+			return &cluster, nil
+		case "SUSPENDING", "SUSPENDED":
+			if cluster.SuspendSchedule != nil {
+				// The suspend_schedule resumes the cluster, keep waiting until it does or the timeout expires.
+				return nil, &util.Err{Err: fmt.Errorf("cluster is %s until its suspend schedule resumes it", cluster.Status)}
+			}
+			return nil, &util.Err{Err: fmt.Errorf("cluster is %s", cluster.Status), Halt: true}
+		case "DELETING", "DELETED":
+			// These never turn into RUNNING on their own.
+			return nil, &util.Err{Err: fmt.Errorf("cluster is %s", cluster.Status), Halt: true}
+		default:
+			return nil, &util.Err{Err: fmt.Errorf("cluster not yet in the RUNNING state. Current state: %s", cluster.Status)}
+		}
+	}, util.DefaultMaxNetworkFailures)
+	if err != nil {
+		resp.Diagnostics.AddError("Cluster is not ready", fmt.Sprintf("Cluster %s did not reach RUNNING: %s", clusterId, err))
+		return
+	}
+
+	state.Status = types.StringValue(cluster.Status)
+	state.ConnectAddress = types.StringValue(cluster.ConnectAddress)
+	state.PrivateLinkAddress = types.StringValue(cluster.PrivateLinkAddress)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,120 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+)
+
+// readClusterReady reads the data source against a server that reports the
+// given statuses in turn, repeating the last one.
+func readClusterReady(t *testing.T, timeout types.String, statuses ...string) (*datasource.ReadResponse, ClusterReadyDataSourceModel) {
+	t.Helper()
+	return readScheduledClusterReady(t, timeout, false, statuses...)
+}
+
+// readScheduledClusterReady is readClusterReady for a cluster that may have a
+// suspend schedule.
+func readScheduledClusterReady(t *testing.T, timeout types.String, scheduled bool, statuses ...string) (*datasource.ReadResponse, ClusterReadyDataSourceModel) {
+	t.Helper()
+	ctx := context.Background()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		i := min(int(calls.Add(1))-1, len(statuses)-1)
+		w.Header().Set("Content-Type", "application/json")
+		schedule := ""
+		if scheduled {
+			schedule = `,"suspendSchedule":{"suspendCron":"0 20 * * 1-5","resumeCron":"0 8 * * 1-5","timezone":"Etc/UTC"}`
+		}
+		_, _ = fmt.Fprintf(w, `{"code":0,"data":{"clusterId":"in01-test","status":%q,"connectAddress":"https://in01-test.zillizcloud.com"%s}}`, statuses[i], schedule)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := zilliz.NewClient(zilliz.WithApiKey("test-api-key"), zilliz.WithBaseUrl(server.URL))
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	d := &ClusterReadyDataSource{client: client}
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	if diags := state.Set(ctx, &ClusterReadyDataSourceModel{
+		ClusterId:          types.StringValue("in01-test"),
+		Timeout:            timeout,
+		Status:             types.StringNull(),
+		ConnectAddress:     types.StringNull(),
+		PrivateLinkAddress: types.StringNull(),
+	}); diags.HasError() {
+		t.Fatalf("set config: %s", diags)
+	}
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: state.Raw}}, resp)
+
+	var model ClusterReadyDataSourceModel
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Get(ctx, &model)...)
+	}
+	return resp, model
+}
+
+func TestClusterReadyDataSourceWaitsForRunning(t *testing.T) {
+	resp, model := readClusterReady(t, types.StringNull(), "CREATING", "RUNNING")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read diagnostics: %s", resp.Diagnostics)
+	}
+	if model.Status.ValueString() != "RUNNING" || model.ConnectAddress.ValueString() != "https://in01-test.zillizcloud.com" {
+		t.Fatalf("state = %+v, want the running cluster", model)
+	}
+}
+
+func TestClusterReadyDataSourceFailsFast(t *testing.T) {
+	tests := []struct {
+		name     string
+		timeout  types.String
+		statuses []string
+		want     string
+	}{
+		{name: "suspended", timeout: types.StringNull(), statuses: []string{"SUSPENDED"}, want: "cluster is SUSPENDED"},
+		{name: "deleting", timeout: types.StringNull(), statuses: []string{"DELETING"}, want: "cluster is DELETING"},
+		{name: "invalid timeout", timeout: types.StringValue("soon"), statuses: []string{"RUNNING"}, want: "not a positive duration"},
+		{name: "timed out", timeout: types.StringValue("1ms"), statuses: []string{"CREATING"}, want: "timed out"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, _ := readClusterReady(t, tt.timeout, tt.statuses...)
+			errs := resp.Diagnostics.Errors()
+			if len(errs) != 1 || !strings.Contains(errs[0].Detail(), tt.want) {
+				t.Fatalf("diagnostics = %v, want one containing %q", resp.Diagnostics, tt.want)
+			}
+		})
+	}
+}
+
+func TestClusterReadyDataSourceWaitsForScheduledResume(t *testing.T) {
+	resp, model := readScheduledClusterReady(t, types.StringNull(), true, "SUSPENDED", "RESUMING", "RUNNING")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read diagnostics: %s", resp.Diagnostics)
+	}
+	if model.Status.ValueString() != "RUNNING" {
+		t.Fatalf("status = %s, want RUNNING once the schedule resumes the cluster", model.Status.ValueString())
+	}
+
+	resp, _ = readScheduledClusterReady(t, types.StringValue("1ms"), true, "SUSPENDED")
+	if errs := resp.Diagnostics.Errors(); len(errs) != 1 || !strings.Contains(errs[0].Detail(), "timed out") {
+		t.Fatalf("diagnostics = %v, want a timeout while the schedule keeps the cluster suspended", resp.Diagnostics)
+	}
+}
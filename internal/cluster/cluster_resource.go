@@ -15,6 +15,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -45,6 +47,7 @@ var (
 	_ resource.Resource                = &ClusterResource{}
 	_ resource.ResourceWithConfigure   = &ClusterResource{}
 	_ resource.ResourceWithImportState = &ClusterResource{}
+	_ resource.ResourceWithModifyPlan  = &ClusterResource{}
 )
 
 func NewClusterResource() resource.Resource {
@@ -68,6 +71,10 @@ func (r *ClusterResource) ConfigValidators(ctx context.Context) []resource.Confi
 			path.MatchRoot("replica"),
 			path.MatchRoot("replica_settings"),
 		),
+		resourcevalidator.RequiredTogether(
+			path.MatchRoot("root_password_wo"),
+			path.MatchRoot("root_password_wo_version"),
+		),
 	}
 }
 
@@ -98,15 +105,18 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 			},
 			"plan": schema.StringAttribute{
-				MarkdownDescription: "The plan tier of the Zilliz Cloud service. Available options are Serverless, Standard and Enterprise.",
+				MarkdownDescription: "The plan tier of the Zilliz Cloud service. Available options are Serverless, Standard and Enterprise. Dedicated clusters can be upgraded in place from Standard to Enterprise to BusinessCritical; downgrades and changes to or from Free and Serverless are rejected at plan time.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 				Validators: []validator.String{
 					stringvalidator.OneOf(FreePlan, ServerlessPlan, StandardPlan, EnterprisePlan, BusinessCriticalPlan),
 				},
 			},
 			"cu_size": schema.Int64Attribute{
-				MarkdownDescription: "The size of the CU to be used for the created cluster. It is an integer from 1 to 256.",
+				MarkdownDescription: "The size of the CU to be used for the created cluster. It is an integer from 1 to 256. When `cu_size` and `replica` change together, the CU is scaled first when it grows and last when it shrinks, waiting for the cluster to be RUNNING between the two steps.",
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers: []planmodifier.Int64{
@@ -117,7 +127,7 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 			},
 			"cu_type": schema.StringAttribute{
-				MarkdownDescription: `The type of the CU used for the Zilliz Cloud cluster to be created. Available options are Performance-optimized, Capacity-optimized and Tiered-storage.`,
+				MarkdownDescription: `The type of the CU used for the Zilliz Cloud cluster to be created. Available options are Performance-optimized, Capacity-optimized and Tiered-storage. A dedicated cluster can migrate in place between Performance-optimized and Capacity-optimized, or from either to Tiered-storage; other changes are rejected at plan time.`,
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString("Performance-optimized"),
@@ -133,13 +143,24 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 			},
 			"password": schema.StringAttribute{
-				MarkdownDescription: "The password of the cluster user generated by default. It will not be displayed again, so note it down and securely store it.",
+				MarkdownDescription: "The password of the cluster user generated by default. It will not be displayed again, so note it down and securely store it. Cleared once `root_password_wo` replaces it.",
 				Computed:            true,
 				Sensitive:           true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					rootPasswordPlanModifier{},
 				},
 			},
+			"root_password_wo": schema.StringAttribute{
+				MarkdownDescription: "The password to set for the cluster user generated by default, as a write-only value that is never stored in the Terraform plan or state. Requires Terraform 1.11 or later. Terraform cannot detect changes to a write-only value, so bump `root_password_wo_version` to rotate the password.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"root_password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "The version of `root_password_wo`. Changing it sends the current `root_password_wo` value to the cluster. Required when `root_password_wo` is set.",
+				Optional:            true,
+			},
 			"prompt": schema.StringAttribute{
 				MarkdownDescription: "The statement indicating that this operation succeeds.",
 				Computed:            true,
@@ -148,19 +169,28 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 			},
 			"description": schema.StringAttribute{
-				MarkdownDescription: "An optional description about the cluster.",
+				MarkdownDescription: "An optional description about the cluster. It can be changed in place; removing it from the configuration keeps the current description, set it to an empty string to clear it.",
+				Optional:            true,
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"public_endpoint_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the cluster can be reached through its public endpoint, `connect_address`. Only dedicated clusters can disable it, after which they are only reachable through `private_link_address`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"region_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the region where the cluster exists.",
+				MarkdownDescription: "The ID of the region where the cluster exists. When the region reports its capabilities, `plan`, `cu_type`, `cu_size` and `replica` are checked against them at plan time.",
 				Optional:            true,
 				Computed:            true,
 			},
 			"desired_status": schema.StringAttribute{
-				MarkdownDescription: "The desired status of the cluster. Possible values are RUNNING and SUSPENDED. Defaults to RUNNING.",
+				MarkdownDescription: "The desired status of the cluster. Possible values are RUNNING and SUSPENDED. Defaults to RUNNING. While `suspend_schedule` is set, the cluster is only suspended or resumed when this value changes, and it cannot be SUSPENDED.",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString("RUNNING"),
@@ -253,17 +283,26 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 					"schedule_scaling": schema.ListNestedAttribute{
 						MarkdownDescription: "Scheduled scaling configuration for query CUs (computing units). Allows you to schedule CU scaling at specific times using cron expressions.",
 						Optional:            true,
+						Validators: []validator.List{
+							customvalidator.ScheduleOverlapValidator{},
+						},
 						NestedObject: schema.NestedAttributeObject{
 							Attributes: map[string]schema.Attribute{
 								"timezone": schema.StringAttribute{
-									MarkdownDescription: "The timezone for the cron expression. Defaults to Etc/UTC.",
+									MarkdownDescription: "The IANA timezone for the cron expression, such as America/New_York. Defaults to Etc/UTC.",
 									Optional:            true,
 									Computed:            true,
-									Default:             stringdefault.StaticString("Etc/UTC"),
+									Default:             stringdefault.StaticString(customvalidator.DefaultScheduleTimezone),
+									Validators: []validator.String{
+										customvalidator.TimezoneValidator{},
+									},
 								},
 								"cron": schema.StringAttribute{
-									MarkdownDescription: "Cron expression defining when the scheduled scaling should occur.",
+									MarkdownDescription: "Cron expression defining when the scheduled scaling should occur, in the 5-field format `minute hour day-of-month month day-of-week`. Schedules firing at the same minute produce a warning.",
 									Required:            true,
+									Validators: []validator.String{
+										customvalidator.CronExpressionValidator{},
+									},
 								},
 								"target": schema.Int64Attribute{
 									MarkdownDescription: "Target number of query CUs (computing units) for the scheduled scaling. Must be at least 1.",
@@ -304,17 +343,26 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 					"schedule_scaling": schema.ListNestedAttribute{
 						MarkdownDescription: "Scheduled scaling configuration for query CU replicas. Allows you to schedule replica scaling at specific times using cron expressions.",
 						Optional:            true,
+						Validators: []validator.List{
+							customvalidator.ScheduleOverlapValidator{},
+						},
 						NestedObject: schema.NestedAttributeObject{
 							Attributes: map[string]schema.Attribute{
 								"timezone": schema.StringAttribute{
-									MarkdownDescription: "The timezone for the cron expression. Defaults to Etc/UTC.",
+									MarkdownDescription: "The IANA timezone for the cron expression, such as America/New_York. Defaults to Etc/UTC.",
 									Optional:            true,
 									Computed:            true,
-									Default:             stringdefault.StaticString("Etc/UTC"),
+									Default:             stringdefault.StaticString(customvalidator.DefaultScheduleTimezone),
+									Validators: []validator.String{
+										customvalidator.TimezoneValidator{},
+									},
 								},
 								"cron": schema.StringAttribute{
-									MarkdownDescription: "Cron expression defining when the scheduled scaling should occur.",
+									MarkdownDescription: "Cron expression defining when the scheduled scaling should occur, in the 5-field format `minute hour day-of-month month day-of-week`. Schedules firing at the same minute produce a warning.",
 									Required:            true,
+									Validators: []validator.String{
+										customvalidator.CronExpressionValidator{},
+									},
 								},
 								"target": schema.Int64Attribute{
 									MarkdownDescription: "Target number of query CU replicas for the scheduled scaling. Must be at least 1.",
@@ -328,6 +376,102 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 					},
 				},
 			},
+			"suspend_schedule": schema.SingleNestedAttribute{
+				MarkdownDescription: "Suspends and resumes the cluster on a cron schedule, such as nights and weekends for development clusters. The schedule is stored by Zilliz Cloud, so transitions happen between applies and do not show up as drift in `status` or `desired_status`. Not available for Free and Serverless clusters.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"suspend_cron": schema.StringAttribute{
+						MarkdownDescription: "Cron expression defining when the cluster is suspended, in the 5-field format `minute hour day-of-month month day-of-week`.",
+						Required:            true,
+						Validators: []validator.String{
+							customvalidator.CronExpressionValidator{},
+						},
+					},
+					"resume_cron": schema.StringAttribute{
+						MarkdownDescription: "Cron expression defining when the cluster is resumed, in the 5-field format `minute hour day-of-month month day-of-week`.",
+						Required:            true,
+						Validators: []validator.String{
+							customvalidator.CronExpressionValidator{},
+						},
+					},
+					"timezone": schema.StringAttribute{
+						MarkdownDescription: "The IANA timezone for both cron expressions, such as America/New_York. Defaults to Etc/UTC.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(customvalidator.DefaultScheduleTimezone),
+						Validators: []validator.String{
+							customvalidator.TimezoneValidator{},
+						},
+					},
+				},
+			},
+			"maintenance_window": schema.SingleNestedAttribute{
+				MarkdownDescription: "The weekly window in which Zilliz Cloud may run maintenance and version upgrades on the cluster, so they can be kept out of change freezes and peak hours. Removing it hands the schedule back to Zilliz Cloud. Not available for Free and Serverless clusters.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"day_of_week": schema.StringAttribute{
+						MarkdownDescription: "The day the window starts on, one of MONDAY, TUESDAY, WEDNESDAY, THURSDAY, FRIDAY, SATURDAY and SUNDAY.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(maintenanceWindowDays...),
+						},
+					},
+					"start_hour": schema.Int64Attribute{
+						MarkdownDescription: "The hour the window starts at, from 0 to 23, in `timezone`.",
+						Required:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(0, 23),
+						},
+					},
+					"timezone": schema.StringAttribute{
+						MarkdownDescription: "The IANA timezone of `start_hour`, such as America/New_York. Defaults to Etc/UTC.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(customvalidator.DefaultScheduleTimezone),
+						Validators: []validator.String{
+							customvalidator.TimezoneValidator{},
+						},
+					},
+					"duration": schema.Int64Attribute{
+						MarkdownDescription: "The length of the window in hours, from 1 to 24.",
+						Required:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 24),
+						},
+					},
+				},
+			},
+			"pending_maintenance": schema.SingleNestedAttribute{
+				MarkdownDescription: "The next maintenance Zilliz Cloud has scheduled for the cluster, if any.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"start_time": schema.StringAttribute{
+						MarkdownDescription: "The time at which the maintenance starts.",
+						Computed:            true,
+					},
+					"description": schema.StringAttribute{
+						MarkdownDescription: "What the maintenance changes, such as the version the cluster is upgraded to.",
+						Computed:            true,
+					},
+				},
+			},
+			"milvus_version": schema.StringAttribute{
+				MarkdownDescription: "The Milvus version the cluster runs.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"target_version": schema.StringAttribute{
+				MarkdownDescription: "The Milvus version to run, such as 2.5.4. New clusters are created with this version. Changing it upgrades the cluster in place and waits until it runs the new version; versions lower than `milvus_version` are rejected at plan time. Zilliz Cloud may still upgrade the cluster past this version during its maintenance window, which does not trigger a change. Use the `zillizcloud_available_versions` data source to list the versions of a region. Not available for Free and Serverless clusters.",
+				Optional:            true,
+			},
+			"wait_for_ready": schema.BoolAttribute{
+				MarkdownDescription: "Whether creation waits for the cluster to reach RUNNING. Set to false to return as soon as the cluster ID is known, and use the `zillizcloud_cluster_ready` data source where a running cluster is needed. Cannot be false together with `root_password_wo`. Defaults to true.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
 			"aws_cse_key_arn": schema.StringAttribute{
 				MarkdownDescription: "The ARN of the AWS KMS key used for client-side encryption (CSE). Only used for BYOC clusters. Immutable after creation.",
 				Optional:            true,
@@ -514,6 +658,10 @@ func (r *ClusterResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 	resp.Diagnostics.Append(validateAutoscalingSettings(tfPlan)...)
 	resp.Diagnostics.Append(validateReplicaPlan(tfPlan)...)
+	resp.Diagnostics.Append(validateSuspendSchedule(tfPlan)...)
+	resp.Diagnostics.Append(validatePublicEndpoint(tfPlan)...)
+	resp.Diagnostics.Append(validateMaintenanceWindow(tfPlan)...)
+	resp.Diagnostics.Append(validateVersionUpgrade(tfPlan, ClusterResourceModel{})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -555,23 +703,61 @@ func (r *ClusterResource) Create(ctx context.Context, req resource.CreateRequest
 		}
 	}
 
+	if tfPlan.hasSuspendSchedule() {
+		ssDiags := r.handleSuspendScheduleUpdate(ctx, tfPlan, tfState)
+		// The schedule stays in state to match the plan, Read finds it missing
+		// server-side and the next apply stores it again.
+		for _, d := range ssDiags.Errors() {
+			resp.Diagnostics.AddWarning(d.Summary(), d.Detail())
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, tfState)...)
 
 	r.tryBestUpdateStatesAfterCreation(ctx, &tfPlan, &tfState, resp)
+
+	if !tfPlan.RootPasswordWoVersion.IsNull() {
+		// The generated password stays in state if this fails, so the cluster
+		// remains reachable. The planned version is kept as configured and the
+		// failure is recorded in private state, so the next plan retries it.
+		if err := r.rotateRootPassword(ctx, req.Config, &tfState); err != nil {
+			resp.Diagnostics.Append(resp.Private.SetKey(ctx, rootPasswordPendingKey, []byte("true"))...)
+			resp.Diagnostics.AddWarning("Failed to set the cluster root password", fmt.Sprintf("%s. The next apply retries it.", err.Error()))
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, tfState)...)
+	}
 }
 
 // tryBestUpdateStatesAfterCreation is called after resource already created, so there're just warnings if anything wrong.
 func (r *ClusterResource) tryBestUpdateStatesAfterCreation(ctx context.Context, plan, state *ClusterResourceModel, resp *resource.CreateResponse) {
+	if !plan.waitsForReady() {
+		// Record whatever the cluster reports right now, Read picks up the rest once it is running.
+		newState, _ := r.getStateAndCheckRunningOnce(ctx, state.ClusterId.ValueString())
+		r.updateStatesAfterCreation(ctx, plan, state, newState, resp)
+		return
+	}
+
 	newState, isRunning := r.getStateAndWaitForRunning(ctx, state.ClusterId.ValueString())
 	if !isRunning {
 		resp.Diagnostics.AddWarning("Cluster created but not in RUNNING state", "The cluster was created successfully, but it is not in the RUNNING state after waiting for the specified timeout. Please check the Zilliz Cloud console for more details or contact support.")
 	}
+	r.updateStatesAfterCreation(ctx, plan, state, newState, resp)
+}
+
+func (r *ClusterResource) updateStatesAfterCreation(ctx context.Context, plan, state, newState *ClusterResourceModel, resp *resource.CreateResponse) {
 	if newState != nil {
 		state.Status = newState.Status
 		state.ConnectAddress = newState.ConnectAddress
 		state.PrivateLinkAddress = newState.PrivateLinkAddress
 		state.CreateTime = newState.CreateTime
-		state.Description = newState.Description
+		if plan.Description.IsUnknown() {
+			state.Description = newState.Description
+		}
+		if plan.PublicEndpointEnabled.IsUnknown() {
+			state.PublicEndpointEnabled = newState.PublicEndpointEnabled
+		}
+		state.PendingMaintenance = newState.PendingMaintenance
+		state.MilvusVersion = newState.MilvusVersion
 		state.RegionId = newState.RegionId
 		state.Plan = newState.Plan
 		if plan.Replica.IsNull() || plan.Replica.IsUnknown() {
@@ -625,6 +811,7 @@ func (r *ClusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 	state.ProjectId = cluster.ProjectId
 	state.RegionId = cluster.RegionId
 	state.Description = cluster.Description
+	state.PublicEndpointEnabled = cluster.PublicEndpointEnabled
 	state.Status = cluster.Status
 	state.ConnectAddress = cluster.ConnectAddress
 	state.PrivateLinkAddress = cluster.PrivateLinkAddress
@@ -639,10 +826,17 @@ func (r *ClusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 	// store.Get returns nil when the cluster has no autoscaling configured.
 	state.CuSettings = cluster.CuSettings
 	state.ReplicaSettings = cluster.ReplicaSettings
+	state.SuspendSchedule = cluster.SuspendSchedule
+	state.MaintenanceWindow = cluster.MaintenanceWindow
+	state.PendingMaintenance = cluster.PendingMaintenance
+	state.MilvusVersion = cluster.MilvusVersion
 
 	if state.DesiredStatus.IsNull() {
 		state.DesiredStatus = cluster.Status
 	}
+	if state.WaitForReady.IsNull() {
+		state.WaitForReady = types.BoolValue(true)
+	}
 
 	state.completeForFreeOrServerless(cluster)
 
@@ -739,18 +933,6 @@ func (r *ClusterResource) handleLabelsUpdate(ctx context.Context, plan, state Cl
 	return diags
 }
 
-func (r *ClusterResource) handleClusterNameUpdate(ctx context.Context, plan, state ClusterResourceModel) diag.Diagnostics {
-	var diags diag.Diagnostics
-
-	err := r.store.ModifyClusterProperties(ctx, state.ClusterId.ValueString(), plan.ClusterName.ValueString())
-	if err != nil {
-		diags.AddError("Failed to modify cluster name", err.Error())
-		return diags
-	}
-
-	return diags
-}
-
 func (r *ClusterResource) handleSecurityGroupsUpdate(ctx context.Context, plan, state ClusterResourceModel) error {
 	// Convert Terraform set to Go slice
 	var securityGroupIds []string
@@ -849,32 +1031,52 @@ func (r *ClusterResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	cuSizeChanged := plan.isCuSizeChanged(state) && plan.isCuSettingsDisabled()
-	replicaChanged := plan.isReplicaChanged(state) && plan.isReplicaSettingsDisabled()
+	if plan.isPlanChanged(state) || plan.isCuTypeChanged(state) {
+		resp.Diagnostics.Append(r.handleTierUpdate(ctx, plan, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Plan = plan.Plan
+		state.CuType = plan.CuType
+	}
 
-	// Validate that fixed cu_size and fixed replica are not changed at the same time
-	if cuSizeChanged && replicaChanged {
-		resp.Diagnostics.AddError("Invalid configuration change", "Cannot change cu_size and replica at the same time. Please update them in separate operations.")
-		return
+	if plan.isVersionUpgradeRequired(state) {
+		resp.Diagnostics.Append(r.handleVersionUpgrade(ctx, plan, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	if cuSizeChanged {
-		resp.Diagnostics.Append(r.handleCuSizeUpdate(ctx, plan, state)...)
+	if steps := planScalingSteps(plan, state); len(steps) > 0 {
+		resp.Diagnostics.Append(r.runScalingSteps(ctx, steps, plan, &state)...)
 		if resp.Diagnostics.HasError() {
+			// Keep the completed steps, so the next apply resumes from the failed one.
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 			return
 		}
 	}
 
-	if replicaChanged {
-		resp.Diagnostics.Append(r.handleReplicaUpdate(ctx, plan, state)...)
+	if plan.isSuspendScheduleChanged(state) {
+		resp.Diagnostics.Append(r.handleSuspendScheduleUpdate(ctx, plan, state)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
+		state.SuspendSchedule = plan.SuspendSchedule
 	}
 
-	resp.Diagnostics.Append(r.handleStatusUpdate(ctx, plan, state)...)
-	if resp.Diagnostics.HasError() {
-		return
+	if plan.isMaintenanceWindowChanged(state) {
+		resp.Diagnostics.Append(r.handleMaintenanceWindowUpdate(ctx, plan, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.MaintenanceWindow = plan.MaintenanceWindow
+	}
+
+	if !plan.isScheduledStatusChange(state) {
+		resp.Diagnostics.Append(r.handleStatusUpdate(ctx, plan, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	if plan.isLabelsChanged(state) {
@@ -885,8 +1087,8 @@ func (r *ClusterResource) Update(ctx context.Context, req resource.UpdateRequest
 		state.Labels = plan.Labels
 	}
 
-	if plan.isClusterNameChanged(state) {
-		resp.Diagnostics.Append(r.handleClusterNameUpdate(ctx, plan, state)...)
+	if plan.isPropertiesChanged(state) {
+		resp.Diagnostics.Append(r.handlePropertiesUpdate(ctx, plan, state)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
@@ -909,6 +1111,18 @@ func (r *ClusterResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	pending := isRootPasswordPending(ctx, req.Private)
+	if plan.isRootPasswordWoVersionChanged(state) || (pending && !plan.RootPasswordWoVersion.IsNull()) {
+		if err := r.rotateRootPassword(ctx, req.Config, &state); err != nil {
+			resp.Diagnostics.AddError("Failed to rotate the cluster root password", err.Error())
+			return
+		}
+	}
+	if pending {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, rootPasswordPendingKey, nil)...)
+	}
+	state.RootPasswordWoVersion = plan.RootPasswordWoVersion
+
 	cluster, err := r.store.Get(ctx, state.ClusterId.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to get cluster", err.Error())
@@ -919,12 +1133,96 @@ func (r *ClusterResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	state.CuSettings = plan.CuSettings
 	state.ReplicaSettings = plan.ReplicaSettings
+	state.SuspendSchedule = plan.SuspendSchedule
+	state.MaintenanceWindow = plan.MaintenanceWindow
+	state.TargetVersion = plan.TargetVersion
+	state.WaitForReady = plan.WaitForReady
+	if plan.hasSuspendSchedule() {
+		// The schedule may have suspended the cluster, keep the configured value.
+		state.DesiredStatus = plan.DesiredStatus
+	}
 	state.Timeouts = plan.Timeouts
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (r *ClusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ClusterResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateSuspendSchedule(plan)...)
+	resp.Diagnostics.Append(validatePublicEndpoint(plan)...)
+	resp.Diagnostics.Append(validateMaintenanceWindow(plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		if !plan.waitsForReady() && !plan.RootPasswordWoVersion.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("wait_for_ready"), "Conflicting cluster settings",
+				"root_password_wo is set on a running cluster during creation, so wait_for_ready cannot be false. Set the password in a later apply, or remove wait_for_ready.")
+			return
+		}
+		resp.Diagnostics.Append(validateRegionCapabilities(plan, r.describeRegion(ctx, plan))...)
+		resp.Diagnostics.Append(validateVersionUpgrade(plan, ClusterResourceModel{})...)
+		if plan.hasTargetVersion() && !resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(validateAvailableVersion(plan, r.listMilvusVersions(ctx, plan))...)
+		}
+		return
+	}
+
+	var state ClusterResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateTierTransition(plan, state)...)
+	resp.Diagnostics.Append(validateVersionUpgrade(plan, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A root password that could not be set during create is set again; the
+	// generated password is cleared once it is.
+	if !plan.RootPasswordWoVersion.IsNull() && isRootPasswordPending(ctx, req.Private) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("password"), types.StringUnknown())...)
+	}
+
+	if plan.isVersionUpgradeRequired(state) {
+		resp.Diagnostics.Append(validateAvailableVersion(plan, r.listMilvusVersions(ctx, plan))...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// The version is only known once the upgrade finishes.
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("milvus_version"), types.StringUnknown())...)
+	}
+
+	// Existing clusters are only checked when their shape changes, so a
+	// capability withdrawn later does not block unrelated updates.
+	if plan.isShapeChanged(state) {
+		resp.Diagnostics.Append(validateRegionCapabilities(plan, r.describeRegion(ctx, plan))...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if steps := planScalingSteps(plan, state); len(steps) > 1 {
+		resp.Diagnostics.AddWarning("Cluster scaling will run in multiple steps",
+			fmt.Sprintf("Cluster %s will be scaled in the following order:\n%s\nIf a step fails, the completed steps are kept in state and the next apply continues with the remaining ones.",
+				state.ClusterId.ValueString(), describeScalingSteps(steps)))
+	}
+}
+
 func (r *ClusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	tflog.Info(ctx, "Delete Cluster...")
 	var data ClusterResourceModel
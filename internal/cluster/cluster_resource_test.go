@@ -17,6 +17,13 @@ func TestAccClusterResource(t *testing.T) {
 		t.Run("FreePlan", testAccClusterResourceFreePlan)
 		t.Run("ServerlessPlan", testAccClusterResourceServerlessPlan)
 		t.Run("StandardPlan", testAccClusterResourceStandardPlan)
+		t.Run("RootPasswordWriteOnly", testAccClusterResourceRootPasswordWriteOnly)
+		t.Run("PlanUpgrade", testAccClusterResourcePlanUpgrade)
+		t.Run("SuspendSchedule", testAccClusterResourceSuspendSchedule)
+		t.Run("WaitForReady", testAccClusterResourceWaitForReady)
+		t.Run("Properties", testAccClusterResourceProperties)
+		t.Run("MaintenanceWindow", testAccClusterResourceMaintenanceWindow)
+		t.Run("VersionUpgrade", testAccClusterResourceVersionUpgrade)
 	})
 	t.Run("BYOCEnv", func(t *testing.T) {
 		t.Run("UpdateLabels", testAccClusterResourceUpdateLabels)
@@ -280,11 +287,390 @@ resource "zillizcloud_cluster" "test" {
 					resource.TestCheckResourceAttrSet("zillizcloud_cluster.test", "connect_address"),
 				),
 			},
+			// Test update cu_size and replica together, replicas are removed before the CU shrinks
+			{
+				Config: provider.ProviderConfig + `
+							data "zillizcloud_project" "default" {
+							}
+							resource "zillizcloud_cluster" "test" {
+								cluster_name = "a-standard-cluster-renamed"
+								region_id    = "aws-us-west-2"
+								plan         = "Standard"
+								cu_size      = "4"                                 # change the cu_size
+								replica      = "1"                                 # change the replica
+								cu_type      = "Performance-optimized"
+								project_id   = data.zillizcloud_project.default.id
+								timeouts {
+									create = "120m"
+									update = "120m"
+								}
+							}
+							`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zillizcloud_cluster.test", "status", "RUNNING"),
+					resource.TestCheckResourceAttr("zillizcloud_cluster.test", "cu_size", "4"),
+					resource.TestCheckResourceAttr("zillizcloud_cluster.test", "replica", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccClusterResourcePlanUpgrade(t *testing.T) {
+	t.Parallel()
+	config := func(plan, cuType string) string {
+		return provider.ProviderConfig + fmt.Sprintf(`
+data "zillizcloud_project" "default" {
+}
+
+resource "zillizcloud_cluster" "test" {
+  cluster_name = "TestPlanUpgrade"
+  region_id    = "aws-us-west-2"
+  plan         = %q
+  cu_size      = 1
+  cu_type      = %q
+  project_id   = data.zillizcloud_project.default.id
+  timeouts {
+    create = "120m"
+    update = "120m"
+  }
+}
+`, plan, cuType)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("Standard", "Performance-optimized"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zillizcloud_cluster.test", "plan", "Standard"),
+					resource.TestCheckResourceAttr("zillizcloud_cluster.test", "cu_type", "Performance-optimized"),
+				),
+			},
+			// Upgrade the plan and migrate the cu_type in place
+			{
+				Config: config("Enterprise", "Capacity-optimized"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zillizcloud_cluster.test", "plan", "Enterprise"),
+					resource.TestCheckResourceAttr("zillizcloud_cluster.test", "cu_type", "Capacity-optimized"),
+					resource.TestCheckResourceAttr("zillizcloud_cluster.test", "status", "RUNNING"),
+				),
+			},
+			// Downgrades are rejected at plan time
+			{
+				Config:      config("Standard", "Capacity-optimized"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Cannot downgrade plan from Enterprise to Standard`),
+			},
+			{
+				Config:      config("Enterprise", "Extended-capacity"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Cannot migrate cu_type from Capacity-optimized to Extended-capacity`),
+			},
+		},
+	})
+}
+
+func testAccClusterResourceSuspendSchedule(t *testing.T) {
+	t.Parallel()
+	config := func(plan, schedule string) string {
+		return provider.ProviderConfig + fmt.Sprintf(`
+data "zillizcloud_project" "default" {
+}
+
+resource "zillizcloud_cluster" "test" {
+  cluster_name = "TestSuspendSchedule"
+  region_id    = "aws-us-west-2"
+  plan         = %q
+  cu_size      = 1
+  cu_type      = "Performance-optimized"
+  project_id   = data.zillizcloud_project.default.id
+%s
+}
+`, plan, schedule)
+	}
+	nightly := `
+  suspend_schedule = {
+    suspend_cron = "0 20 * * 1-5"
+    resume_cron  = "0 8 * * 1-5"
+    timezone     = "Europe/Berlin"
+  }
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("Standard", nightly),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zillizcloud_cluster.test", "suspend_schedule.suspend_cron", "0 20 * * 1-5"),
+					resource.TestCheckResourceAttr("zillizcloud_cluster.test", "suspend_schedule.resume_cron", "0 8 * * 1-5"),
+					resource.TestCheckResourceAttr("zillizcloud_cluster.test", "suspend_schedule.timezone", "Europe/Berlin"),
+					resource.TestCheckResourceAttr("zillizcloud_cluster.test", "desired_status", "RUNNING"),
+				),
+			},
+			// Removing the schedule deletes it server-side
+			{
+				Config: config("Standard", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("zillizcloud_cluster.test", "suspend_schedule.suspend_cron"),
+				),
+			},
+			// Unsupported plans and a suspended desired_status are rejected at plan time
+			{
+				Config:      config("Serverless", nightly),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Suspend schedule not supported`),
+			},
+			{
+				Config:      config("Standard", nightly+`  desired_status = "SUSPENDED"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`desired_status cannot be SUSPENDED`),
+			},
+		},
+	})
+}
+
+func testAccClusterResourceProperties(t *testing.T) {
+	t.Parallel()
+	config := func(plan, properties string) string {
+		return provider.ProviderConfig + fmt.Sprintf(`
+data "zillizcloud_project" "default" {
+}
+
+resource "zillizcloud_cluster" "test" {
+  cluster_name = "TestProperties"
+  region_id    = "aws-us-west-2"
+  plan         = %q
+  cu_size      = 1
+  cu_type      = "Performance-optimized"
+  project_id   = data.zillizcloud_project.default.id
+%s
+}
+`, plan, properties)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("Standard", `  description = "orders service"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zillizcloud_cluster.test", "description", "orders service"),
+					resource.TestCheckResourceAttr("zillizcloud_cluster.test", "public_endpoint_enabled", "true"),
+				),
+			},
+			// Both properties are changed in place
+			{
+				Config: config("Standard", `
+  description             = "orders service, EU"
+  public_endpoint_enabled = false
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zillizcloud_cluster.test", "description", "orders service, EU"),
+					resource.TestCheckResourceAttr("zillizcloud_cluster.test", "public_endpoint_enabled", "false"),
+				),
+			},
+			{
+				Config:      config("Serverless", `  public_endpoint_enabled = false`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Public endpoint cannot be disabled`),
+			},
+		},
+	})
+}
+
+func testAccClusterResourceMaintenanceWindow(t *testing.T) {
+	t.Parallel()
+	config := func(plan, window string) string {
+		return provider.ProviderConfig + fmt.Sprintf(`
+data "zillizcloud_project" "default" {
+}
+
+resource "zillizcloud_cluster" "test" {
+  cluster_name = "TestMaintenanceWindow"
+  region_id    = "aws-us-west-2"
+  plan         = %q
+  cu_size      = 1
+  cu_type      = "Performance-optimized"
+  project_id   = data.zillizcloud_project.default.id
+%s
+}
+`, plan, window)
+	}
+	window := func(day string, startHour int) string {
+		return fmt.Sprintf(`
+  maintenance_window = {
+    day_of_week = %q
+    start_hour  = %d
+    timezone    = "Europe/Berlin"
+    duration    = 4
+  }
+`, day, startHour)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("Standard", window("SUNDAY", 2)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zillizcloud_cluster.test", "maintenance_window.day_of_week", "SUNDAY"),
+					resource.TestCheckResourceAttr("zillizcloud_cluster.test", "maintenance_window.start_hour", "2"),
+					resource.TestCheckResourceAttr("zillizcloud_cluster.test", "maintenance_window.timezone", "Europe/Berlin"),
+					resource.TestCheckResourceAttr("zillizcloud_cluster.test", "maintenance_window.duration", "4"),
+				),
+			},
+			{
+				Config: config("Standard", window("SATURDAY", 22)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zillizcloud_cluster.test", "maintenance_window.day_of_week", "SATURDAY"),
+					resource.TestCheckResourceAttr("zillizcloud_cluster.test", "maintenance_window.start_hour", "22"),
+				),
+			},
+			// Removing the window deletes it server-side
+			{
+				Config: config("Standard", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("zillizcloud_cluster.test", "maintenance_window.day_of_week"),
+				),
+			},
+			{
+				Config:      config("Serverless", window("SUNDAY", 2)),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Maintenance window not supported`),
+			},
+		},
+	})
+}
+
+func testAccClusterResourceVersionUpgrade(t *testing.T) {
+	t.Parallel()
+	config := func(targetVersion string) string {
+		return provider.ProviderConfig + fmt.Sprintf(`
+data "zillizcloud_project" "default" {
+}
+
+data "zillizcloud_available_versions" "test" {
+  region_id = "aws-us-west-2"
+}
+
+resource "zillizcloud_cluster" "test" {
+  cluster_name   = "TestVersionUpgrade"
+  region_id      = "aws-us-west-2"
+  plan           = "Standard"
+  cu_size        = 1
+  cu_type        = "Performance-optimized"
+  project_id     = data.zillizcloud_project.default.id
+  target_version = %q
+}
+`, targetVersion)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("2.4.17"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.zillizcloud_available_versions.test", "default_version"),
+					resource.TestCheckResourceAttr("zillizcloud_cluster.test", "milvus_version", "2.4.17"),
+					resource.TestCheckResourceAttr("zillizcloud_cluster.test", "target_version", "2.4.17"),
+				),
+			},
+			{
+				Config: config("2.5.4"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zillizcloud_cluster.test", "milvus_version", "2.5.4"),
+					resource.TestCheckResourceAttr("zillizcloud_cluster.test", "status", "RUNNING"),
+				),
+			},
+			{
+				Config:      config("2.4.17"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Unsupported version change`),
+			},
+		},
+	})
+}
+
+func testAccClusterResourceWaitForReady(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: provider.ProviderConfig + `
+data "zillizcloud_project" "default" {
+}
+
+resource "zillizcloud_cluster" "test" {
+  cluster_name   = "TestWaitForReady"
+  region_id      = "aws-us-west-2"
+  plan           = "Standard"
+  cu_size        = 1
+  cu_type        = "Performance-optimized"
+  project_id     = data.zillizcloud_project.default.id
+  wait_for_ready = false
+}
+
+data "zillizcloud_cluster_ready" "test" {
+  cluster_id = zillizcloud_cluster.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zillizcloud_cluster.test", "wait_for_ready", "false"),
+					resource.TestCheckResourceAttrSet("zillizcloud_cluster.test", "id"),
+					resource.TestCheckResourceAttr("data.zillizcloud_cluster_ready.test", "status", "RUNNING"),
+					resource.TestCheckResourceAttrSet("data.zillizcloud_cluster_ready.test", "connect_address"),
+				),
+			},
 		},
 	})
 }
 
 // test update labels
+func testAccClusterResourceRootPasswordWriteOnly(t *testing.T) {
+	t.Parallel()
+	config := func(password string, version int) string {
+		return provider.ProviderConfig + fmt.Sprintf(`
+data "zillizcloud_project" "default" {
+}
+
+resource "zillizcloud_cluster" "test" {
+  cluster_name             = "TestRootPasswordWo"
+  plan                     = "Serverless"
+  region_id                = "gcp-us-west1"
+  project_id               = data.zillizcloud_project.default.id
+  root_password_wo         = %q
+  root_password_wo_version = %d
+}
+`, password, version)
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("LZ0lS#FRU5V49$2q", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zillizcloud_cluster.test", "status", "RUNNING"),
+					resource.TestCheckResourceAttr("zillizcloud_cluster.test", "root_password_wo_version", "1"),
+					resource.TestCheckNoResourceAttr("zillizcloud_cluster.test", "root_password_wo"),
+					resource.TestCheckNoResourceAttr("zillizcloud_cluster.test", "password"),
+				),
+			},
+			{
+				Config: config("NewP@ssw0rd123!", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zillizcloud_cluster.test", "root_password_wo_version", "2"),
+					resource.TestCheckNoResourceAttr("zillizcloud_cluster.test", "password"),
+				),
+			},
+		},
+	})
+}
+
 func testAccClusterResourceUpdateLabels(t *testing.T) {
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
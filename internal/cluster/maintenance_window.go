@@ -0,0 +1,89 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+)
+
+var maintenanceWindowDays = []string{"MONDAY", "TUESDAY", "WEDNESDAY", "THURSDAY", "FRIDAY", "SATURDAY", "SUNDAY"}
+
+var pendingMaintenanceAttrTypes = map[string]attr.Type{
+	"start_time":  types.StringType,
+	"description": types.StringType,
+}
+
+func (c *ClusterResourceModel) hasMaintenanceWindow() bool {
+	return c.MaintenanceWindow != nil
+}
+
+func (c *ClusterResourceModel) isMaintenanceWindowChanged(other ClusterResourceModel) bool {
+	return !c.MaintenanceWindow.Equal(other.MaintenanceWindow)
+}
+
+// validateMaintenanceWindow rejects a maintenance window on Free and
+// Serverless clusters, whose maintenance is not scheduled per cluster.
+func validateMaintenanceWindow(plan ClusterResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !plan.hasMaintenanceWindow() {
+		return diags
+	}
+
+	if planValue := plan.Plan.ValueString(); !plan.Plan.IsUnknown() && (planValue == FreePlan || planValue == ServerlessPlan) {
+		diags.AddAttributeError(path.Root("maintenance_window"), "Maintenance window not supported",
+			fmt.Sprintf("%s clusters are maintained by Zilliz Cloud, maintenance_window requires a Standard, Enterprise or BusinessCritical plan.", planValue))
+	}
+	return diags
+}
+
+func (w *MaintenanceWindow) toAPI() *zilliz.MaintenanceWindow {
+	if w == nil {
+		return nil
+	}
+	return &zilliz.MaintenanceWindow{
+		DayOfWeek: w.DayOfWeek.ValueString(),
+		StartHour: int(w.StartHour.ValueInt64()),
+		Timezone:  w.Timezone.ValueString(),
+		Duration:  int(w.Duration.ValueInt64()),
+	}
+}
+
+func maintenanceWindowFromAPI(w *zilliz.MaintenanceWindow) *MaintenanceWindow {
+	if w == nil {
+		return nil
+	}
+	return &MaintenanceWindow{
+		DayOfWeek: types.StringValue(w.DayOfWeek),
+		StartHour: types.Int64Value(int64(w.StartHour)),
+		Timezone:  types.StringValue(w.Timezone),
+		Duration:  types.Int64Value(int64(w.Duration)),
+	}
+}
+
+// pendingMaintenanceValue converts the next scheduled maintenance reported by
+// the API, returning a null object when none is scheduled.
+func pendingMaintenanceValue(p *zilliz.PendingMaintenance) types.Object {
+	if p == nil {
+		return types.ObjectNull(pendingMaintenanceAttrTypes)
+	}
+	return types.ObjectValueMust(pendingMaintenanceAttrTypes, map[string]attr.Value{
+		"start_time":  types.StringValue(p.StartTime),
+		"description": types.StringValue(p.Description),
+	})
+}
+
+func (r *ClusterResource) handleMaintenanceWindowUpdate(ctx context.Context, plan, state ClusterResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := r.store.UpdateMaintenanceWindow(ctx, state.ClusterId.ValueString(), plan.MaintenanceWindow)
+	if err != nil {
+		diags.AddError("Failed to update cluster maintenance window", err.Error())
+	}
+	return diags
+}
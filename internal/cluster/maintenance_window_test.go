@@ -0,0 +1,96 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+)
+
+type maintenanceWindowFakeStore struct {
+	ClusterStore
+	windows []*MaintenanceWindow
+}
+
+func (s *maintenanceWindowFakeStore) UpdateMaintenanceWindow(ctx context.Context, clusterId string, window *MaintenanceWindow) error {
+	s.windows = append(s.windows, window)
+	return nil
+}
+
+func sundayWindow() *MaintenanceWindow {
+	return &MaintenanceWindow{
+		DayOfWeek: types.StringValue("SUNDAY"),
+		StartHour: types.Int64Value(2),
+		Timezone:  types.StringValue("Europe/Berlin"),
+		Duration:  types.Int64Value(4),
+	}
+}
+
+func TestValidateMaintenanceWindow(t *testing.T) {
+	tests := []struct {
+		name    string
+		plan    ClusterResourceModel
+		wantErr bool
+	}{
+		{name: "no window on free plan", plan: ClusterResourceModel{Plan: types.StringValue(FreePlan)}},
+		{name: "window on enterprise plan", plan: ClusterResourceModel{Plan: types.StringValue(EnterprisePlan), MaintenanceWindow: sundayWindow()}},
+		{name: "window with unknown plan", plan: ClusterResourceModel{Plan: types.StringUnknown(), MaintenanceWindow: sundayWindow()}},
+		{name: "window on serverless plan", plan: ClusterResourceModel{Plan: types.StringValue(ServerlessPlan), MaintenanceWindow: sundayWindow()}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateMaintenanceWindow(tt.plan)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("HasError = %t, want %t: %s", diags.HasError(), tt.wantErr, diags)
+			}
+		})
+	}
+}
+
+func TestMaintenanceWindowAPIRoundTrip(t *testing.T) {
+	if got := maintenanceWindowFromAPI(sundayWindow().toAPI()); !got.Equal(sundayWindow()) {
+		t.Fatalf("round trip = %+v, want %+v", got, sundayWindow())
+	}
+
+	var none *MaintenanceWindow
+	if none.toAPI() != nil || maintenanceWindowFromAPI(nil) != nil {
+		t.Fatal("a missing window should stay missing")
+	}
+
+	other := sundayWindow()
+	other.Duration = types.Int64Value(2)
+	if sundayWindow().Equal(other) || sundayWindow().Equal(nil) {
+		t.Fatal("windows of different length should differ")
+	}
+}
+
+func TestPendingMaintenanceValue(t *testing.T) {
+	if v := pendingMaintenanceValue(nil); !v.IsNull() {
+		t.Fatalf("value = %s, want null when nothing is scheduled", v)
+	}
+
+	v := pendingMaintenanceValue(&zilliz.PendingMaintenance{StartTime: "2026-10-25T02:00:00Z", Description: "Upgrade to Milvus 2.5"})
+	attrs := v.Attributes()
+	if attrs["start_time"].(types.String).ValueString() != "2026-10-25T02:00:00Z" || attrs["description"].(types.String).ValueString() != "Upgrade to Milvus 2.5" {
+		t.Fatalf("value = %s, want the scheduled upgrade", v)
+	}
+}
+
+func TestHandleMaintenanceWindowUpdate(t *testing.T) {
+	store := &maintenanceWindowFakeStore{}
+	r := &ClusterResource{store: store}
+	state := ClusterResourceModel{ClusterId: types.StringValue("in01-test")}
+
+	if diags := r.handleMaintenanceWindowUpdate(context.Background(), ClusterResourceModel{MaintenanceWindow: sundayWindow()}, state); diags.HasError() {
+		t.Fatalf("store window: %s", diags)
+	}
+	if diags := r.handleMaintenanceWindowUpdate(context.Background(), ClusterResourceModel{}, state); diags.HasError() {
+		t.Fatalf("remove window: %s", diags)
+	}
+
+	if len(store.windows) != 2 || !store.windows[0].Equal(sundayWindow()) || store.windows[1] != nil {
+		t.Fatalf("stored windows = %v, want the window then a removal", store.windows)
+	}
+}
@@ -128,6 +128,65 @@ type ClusterResourceModel struct {
 	BucketInfo         *BucketInfo      `tfsdk:"bucket_info"`
 	AwsCseKeyArn       types.String     `tfsdk:"aws_cse_key_arn"`
 	Timeouts           timeouts.Value   `tfsdk:"timeouts"`
+
+	// RootPasswordWo is write-only: it is only set in the config, never in the plan or state.
+	RootPasswordWo        types.String `tfsdk:"root_password_wo"`
+	RootPasswordWoVersion types.Int64  `tfsdk:"root_password_wo_version"`
+
+	SuspendSchedule       *SuspendSchedule   `tfsdk:"suspend_schedule"`
+	WaitForReady          types.Bool         `tfsdk:"wait_for_ready"`
+	PublicEndpointEnabled types.Bool         `tfsdk:"public_endpoint_enabled"`
+	MaintenanceWindow     *MaintenanceWindow `tfsdk:"maintenance_window"`
+	PendingMaintenance    types.Object       `tfsdk:"pending_maintenance"`
+	MilvusVersion         types.String       `tfsdk:"milvus_version"`
+	TargetVersion         types.String       `tfsdk:"target_version"`
+}
+
+// SuspendSchedule suspends and resumes the cluster on a cron schedule. The
+// schedule is stored server-side, so transitions happen between applies.
+type SuspendSchedule struct {
+	SuspendCron types.String `tfsdk:"suspend_cron"`
+	ResumeCron  types.String `tfsdk:"resume_cron"`
+	Timezone    types.String `tfsdk:"timezone"`
+}
+
+func (s *SuspendSchedule) Equal(other *SuspendSchedule) bool {
+	if s == nil && other == nil {
+		return true
+	}
+	if s == nil || other == nil {
+		return false
+	}
+	return s.SuspendCron.Equal(other.SuspendCron) && s.ResumeCron.Equal(other.ResumeCron) && s.Timezone.Equal(other.Timezone)
+}
+
+// MaintenanceWindow is the weekly window in which Zilliz Cloud may run
+// maintenance and version upgrades on the cluster.
+type MaintenanceWindow struct {
+	DayOfWeek types.String `tfsdk:"day_of_week"`
+	StartHour types.Int64  `tfsdk:"start_hour"`
+	Timezone  types.String `tfsdk:"timezone"`
+	Duration  types.Int64  `tfsdk:"duration"`
+}
+
+func (w *MaintenanceWindow) Equal(other *MaintenanceWindow) bool {
+	if w == nil && other == nil {
+		return true
+	}
+	if w == nil || other == nil {
+		return false
+	}
+	return w.DayOfWeek.Equal(other.DayOfWeek) && w.StartHour.Equal(other.StartHour) && w.Timezone.Equal(other.Timezone) && w.Duration.Equal(other.Duration)
+}
+
+// waitsForReady reports whether creation waits for RUNNING, which is the
+// default when wait_for_ready is not set.
+func (c *ClusterResourceModel) waitsForReady() bool {
+	return c.WaitForReady.IsNull() || c.WaitForReady.IsUnknown() || c.WaitForReady.ValueBool()
+}
+
+func (c *ClusterResourceModel) isSuspendScheduleChanged(other ClusterResourceModel) bool {
+	return !c.SuspendSchedule.Equal(other.SuspendSchedule)
 }
 
 type BucketInfo struct {
@@ -166,7 +225,14 @@ func (c *ClusterResourceModel) setUnknown() {
 	c.PrivateLinkAddress = unknown
 	c.CreateTime = unknown
 	c.Status = unknown
-	c.Description = unknown
+	if c.Description.IsUnknown() {
+		c.Description = unknown
+	}
+	if c.PublicEndpointEnabled.IsUnknown() {
+		c.PublicEndpointEnabled = types.BoolValue(true)
+	}
+	c.PendingMaintenance = types.ObjectNull(pendingMaintenanceAttrTypes)
+	c.MilvusVersion = unknown
 	if c.RegionId.IsNull() {
 		c.RegionId = unknown
 	}
@@ -182,6 +248,9 @@ func (c *ClusterResourceModel) populate(input *ClusterResourceModel) {
 		c.RegionId = input.RegionId
 	}
 	c.Description = input.Description
+	c.PublicEndpointEnabled = input.PublicEndpointEnabled
+	c.PendingMaintenance = input.PendingMaintenance
+	c.MilvusVersion = input.MilvusVersion
 	c.Status = input.Status
 	c.DesiredStatus = input.Status
 	c.ConnectAddress = input.ConnectAddress
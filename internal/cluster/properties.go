@@ -0,0 +1,63 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+)
+
+func (c *ClusterResourceModel) isDescriptionChanged(other ClusterResourceModel) bool {
+	return !c.Description.IsUnknown() && !c.Description.IsNull() && c.Description.ValueString() != other.Description.ValueString()
+}
+
+func (c *ClusterResourceModel) isPublicEndpointEnabledChanged(other ClusterResourceModel) bool {
+	return !c.PublicEndpointEnabled.IsUnknown() && !c.PublicEndpointEnabled.IsNull() && !c.PublicEndpointEnabled.Equal(other.PublicEndpointEnabled)
+}
+
+// isPropertiesChanged reports whether any setting applied through the
+// modifyProperties API differs between the plan and the state.
+func (c *ClusterResourceModel) isPropertiesChanged(other ClusterResourceModel) bool {
+	return c.isClusterNameChanged(other) || c.isDescriptionChanged(other) || c.isPublicEndpointEnabledChanged(other)
+}
+
+// validatePublicEndpoint rejects disabling the public endpoint of Free and
+// Serverless clusters, which can only be reached through it.
+func validatePublicEndpoint(plan ClusterResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if plan.PublicEndpointEnabled.IsNull() || plan.PublicEndpointEnabled.IsUnknown() || plan.PublicEndpointEnabled.ValueBool() {
+		return diags
+	}
+
+	if planValue := plan.Plan.ValueString(); planValue == FreePlan || planValue == ServerlessPlan {
+		diags.AddAttributeError(path.Root("public_endpoint_enabled"), "Public endpoint cannot be disabled",
+			fmt.Sprintf("%s clusters are only reachable through their public endpoint, public_endpoint_enabled requires a Standard, Enterprise or BusinessCritical plan.", planValue))
+	}
+	return diags
+}
+
+// handlePropertiesUpdate sends the changed properties in one modifyProperties
+// request, leaving the unchanged ones out of the payload.
+func (r *ClusterResource) handlePropertiesUpdate(ctx context.Context, plan, state ClusterResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	params := &zilliz.ModifyPropertiesParams{}
+	if plan.isClusterNameChanged(state) {
+		params.ClusterName = plan.ClusterName.ValueString()
+	}
+	if plan.isDescriptionChanged(state) {
+		params.Description = plan.Description.ValueStringPointer()
+	}
+	if plan.isPublicEndpointEnabledChanged(state) {
+		params.PublicEndpointEnabled = plan.PublicEndpointEnabled.ValueBoolPointer()
+	}
+
+	err := r.store.ModifyClusterProperties(ctx, state.ClusterId.ValueString(), params)
+	if err != nil {
+		diags.AddError("Failed to modify cluster properties", err.Error())
+	}
+	return diags
+}
@@ -0,0 +1,95 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+)
+
+type propertiesFakeStore struct {
+	ClusterStore
+	params []*zilliz.ModifyPropertiesParams
+}
+
+func (s *propertiesFakeStore) ModifyClusterProperties(ctx context.Context, clusterId string, params *zilliz.ModifyPropertiesParams) error {
+	s.params = append(s.params, params)
+	return nil
+}
+
+func propertiesModel(name, description string, publicEndpoint bool) ClusterResourceModel {
+	return ClusterResourceModel{
+		ClusterId:             types.StringValue("in01-test"),
+		ClusterName:           types.StringValue(name),
+		Description:           types.StringValue(description),
+		PublicEndpointEnabled: types.BoolValue(publicEndpoint),
+	}
+}
+
+func TestIsPropertiesChanged(t *testing.T) {
+	state := propertiesModel("prod", "orders", true)
+
+	plan := propertiesModel("prod", "orders", true)
+	if plan.isPropertiesChanged(state) {
+		t.Fatal("identical properties should not change")
+	}
+	for name, plan := range map[string]ClusterResourceModel{
+		"cluster name":    propertiesModel("prod-eu", "orders", true),
+		"description":     propertiesModel("prod", "", true),
+		"public endpoint": propertiesModel("prod", "orders", false),
+	} {
+		if !plan.isPropertiesChanged(state) {
+			t.Errorf("%s change not detected", name)
+		}
+	}
+
+	plan = state
+	plan.Description = types.StringUnknown()
+	plan.PublicEndpointEnabled = types.BoolNull()
+	if plan.isPropertiesChanged(state) {
+		t.Fatal("unknown and null values should not change")
+	}
+}
+
+func TestValidatePublicEndpoint(t *testing.T) {
+	tests := []struct {
+		name    string
+		plan    string
+		enabled types.Bool
+		wantErr bool
+	}{
+		{name: "not set on free plan", plan: FreePlan, enabled: types.BoolNull()},
+		{name: "enabled on serverless plan", plan: ServerlessPlan, enabled: types.BoolValue(true)},
+		{name: "disabled on standard plan", plan: StandardPlan, enabled: types.BoolValue(false)},
+		{name: "disabled on serverless plan", plan: ServerlessPlan, enabled: types.BoolValue(false), wantErr: true},
+		{name: "disabled on free plan", plan: FreePlan, enabled: types.BoolValue(false), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validatePublicEndpoint(ClusterResourceModel{Plan: types.StringValue(tt.plan), PublicEndpointEnabled: tt.enabled})
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("HasError = %t, want %t: %s", diags.HasError(), tt.wantErr, diags)
+			}
+		})
+	}
+}
+
+func TestHandlePropertiesUpdateSendsChangedFieldsOnly(t *testing.T) {
+	store := &propertiesFakeStore{}
+	r := &ClusterResource{store: store}
+
+	diags := r.handlePropertiesUpdate(context.Background(), propertiesModel("prod", "", false), propertiesModel("prod", "orders", true))
+	if diags.HasError() {
+		t.Fatalf("handlePropertiesUpdate diagnostics: %s", diags)
+	}
+
+	if len(store.params) != 1 {
+		t.Fatalf("ModifyClusterProperties calls = %d, want 1", len(store.params))
+	}
+	got := store.params[0]
+	if got.ClusterName != "" || got.Description == nil || *got.Description != "" || got.PublicEndpointEnabled == nil || *got.PublicEndpointEnabled {
+		t.Fatalf("params = %+v, want a cleared description and a disabled public endpoint only", got)
+	}
+}
@@ -0,0 +1,81 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// rootPasswordPlanModifier marks the computed password unknown whenever
+// root_password_wo_version changes: the generated password is dropped from
+// state once root_password_wo has replaced it.
+type rootPasswordPlanModifier struct{}
+
+func (m rootPasswordPlanModifier) Description(_ context.Context) string {
+	return "The generated password is removed from state once root_password_wo is applied."
+}
+
+func (m rootPasswordPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m rootPasswordPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	var configVersion types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("root_password_wo_version"), &configVersion)...)
+	if resp.Diagnostics.HasError() || configVersion.IsNull() {
+		return
+	}
+
+	var stateVersion types.Int64
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("root_password_wo_version"), &stateVersion)...)
+	}
+	if !configVersion.Equal(stateVersion) {
+		resp.PlanValue = types.StringUnknown()
+	}
+}
+
+// rootPasswordPendingKey marks, in private state, a root_password_wo that
+// could not be set during create. The configured version is kept in state, so
+// the flag is what makes the next plan set the password again.
+const rootPasswordPendingKey = "root_password_pending"
+
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+func isRootPasswordPending(ctx context.Context, private privateState) bool {
+	value, diags := private.GetKey(ctx, rootPasswordPendingKey)
+	return !diags.HasError() && len(value) > 0
+}
+
+func (c *ClusterResourceModel) isRootPasswordWoVersionChanged(other ClusterResourceModel) bool {
+	return !c.RootPasswordWoVersion.IsNull() && !c.RootPasswordWoVersion.Equal(other.RootPasswordWoVersion)
+}
+
+// rotateRootPassword sets the cluster's default user password to the
+// configured root_password_wo. The write-only value is only available in the
+// config, and the current password is not known after the first rotation, so
+// the password is reset rather than changed. On success the generated
+// password is cleared from state.
+func (r *ClusterResource) rotateRootPassword(ctx context.Context, config tfsdk.Config, state *ClusterResourceModel) error {
+	var password types.String
+	if diags := config.GetAttribute(ctx, path.Root("root_password_wo"), &password); diags.HasError() {
+		return fmt.Errorf("failed to read root_password_wo: %s", diags.Errors()[0].Detail())
+	}
+	if state.Username.IsNull() || state.Username.ValueString() == "" {
+		return fmt.Errorf("the cluster's default username is unknown, so the root password cannot be rotated")
+	}
+
+	err := r.store.ResetPassword(ctx, state.ClusterId.ValueString(), state.Username.ValueString(), password.ValueString())
+	if err != nil {
+		return err
+	}
+	state.Password = types.StringNull()
+	return nil
+}
@@ -0,0 +1,69 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type passwordFakeStore struct {
+	ClusterStore
+	resets []string
+}
+
+func (s *passwordFakeStore) ResetPassword(ctx context.Context, clusterId string, username string, password string) error {
+	s.resets = append(s.resets, clusterId+"/"+username+"/"+password)
+	return nil
+}
+
+func TestRotateRootPasswordWithoutCurrentPassword(t *testing.T) {
+	ctx := context.Background()
+	store := &passwordFakeStore{}
+	r := &ClusterResource{store: store}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	raw := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	if diags := raw.SetAttribute(ctx, path.Root("root_password_wo"), "NewP@ssw0rd123!"); diags.HasError() {
+		t.Fatalf("Set config: %v", diags)
+	}
+	config := tfsdk.Config{Schema: schemaResp.Schema, Raw: raw.Raw}
+
+	// After the first rotation the generated password is no longer in state.
+	state := ClusterResourceModel{
+		ClusterId: types.StringValue("in01-test"),
+		Username:  types.StringValue("db_admin"),
+		Password:  types.StringNull(),
+	}
+	if err := r.rotateRootPassword(ctx, config, &state); err != nil {
+		t.Fatalf("rotateRootPassword: %v", err)
+	}
+	if len(store.resets) != 1 || store.resets[0] != "in01-test/db_admin/NewP@ssw0rd123!" {
+		t.Fatalf("ResetPassword calls = %v, want one reset of db_admin", store.resets)
+	}
+	if !state.Password.IsNull() {
+		t.Errorf("password = %v, want null", state.Password)
+	}
+}
+
+type fakePrivateState map[string][]byte
+
+func (p fakePrivateState) GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func TestIsRootPasswordPending(t *testing.T) {
+	ctx := context.Background()
+	if isRootPasswordPending(ctx, fakePrivateState{}) {
+		t.Error("pending without a recorded failure")
+	}
+	if !isRootPasswordPending(ctx, fakePrivateState{rootPasswordPendingKey: []byte("true")}) {
+		t.Error("want pending after a failed rotation during create")
+	}
+}
@@ -0,0 +1,107 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	scalingAttributeCuSize  = "cu_size"
+	scalingAttributeReplica = "replica"
+)
+
+// scalingStep is a single fixed capacity change applied during Update. Each
+// step waits for the cluster to return to RUNNING before the next one starts.
+type scalingStep struct {
+	attribute string
+	from      int64
+	to        int64
+}
+
+func (s scalingStep) String() string {
+	return fmt.Sprintf("change %s from %d to %d, then wait for RUNNING", s.attribute, s.from, s.to)
+}
+
+// apply records the step as completed on the given model.
+func (s scalingStep) apply(model *ClusterResourceModel) {
+	switch s.attribute {
+	case scalingAttributeCuSize:
+		model.CuSize = types.Int64Value(s.to)
+	case scalingAttributeReplica:
+		model.Replica = types.Int64Value(s.to)
+	}
+}
+
+// planScalingSteps returns the cu_size and replica changes between state and
+// plan in the order they must run. CU is scaled first when it grows, so that
+// the new replica count fits the larger CU size, and last when it shrinks, so
+// that replicas are removed before the CU size drops below what they need.
+// Attributes managed by autoscaling settings are not part of the plan.
+func planScalingSteps(plan, state ClusterResourceModel) []scalingStep {
+	var cuStep, replicaStep *scalingStep
+
+	if isKnownInt64(plan.CuSize) && plan.isCuSizeChanged(state) && plan.isCuSettingsDisabled() {
+		cuStep = &scalingStep{attribute: scalingAttributeCuSize, from: state.CuSize.ValueInt64(), to: plan.CuSize.ValueInt64()}
+	}
+	if isKnownInt64(plan.Replica) && plan.isReplicaChanged(state) && plan.isReplicaSettingsDisabled() {
+		replicaStep = &scalingStep{attribute: scalingAttributeReplica, from: state.Replica.ValueInt64(), to: plan.Replica.ValueInt64()}
+	}
+
+	ordered := []*scalingStep{cuStep, replicaStep}
+	if cuStep != nil && cuStep.to < cuStep.from {
+		ordered = []*scalingStep{replicaStep, cuStep}
+	}
+
+	steps := make([]scalingStep, 0, len(ordered))
+	for _, step := range ordered {
+		if step != nil {
+			steps = append(steps, *step)
+		}
+	}
+	return steps
+}
+
+func isKnownInt64(v types.Int64) bool {
+	return !v.IsNull() && !v.IsUnknown()
+}
+
+// describeScalingSteps renders the steps as a numbered list for plan output.
+func describeScalingSteps(steps []scalingStep) string {
+	lines := make([]string, 0, len(steps))
+	for i, step := range steps {
+		lines = append(lines, fmt.Sprintf("%d. %s", i+1, step))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// runScalingSteps executes the steps in order. Completed steps are recorded on
+// state, so when a step fails the caller can persist the partial progress and
+// the next apply only plans the remaining steps.
+func (r *ClusterResource) runScalingSteps(ctx context.Context, steps []scalingStep, plan ClusterResourceModel, state *ClusterResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for i, step := range steps {
+		var stepDiags diag.Diagnostics
+		switch step.attribute {
+		case scalingAttributeCuSize:
+			stepDiags = r.handleCuSizeUpdate(ctx, plan, *state)
+		case scalingAttributeReplica:
+			stepDiags = r.handleReplicaUpdate(ctx, plan, *state)
+		}
+		diags.Append(stepDiags...)
+		if diags.HasError() {
+			if len(steps) > 1 {
+				diags.AddError("Cluster scaling stopped",
+					fmt.Sprintf("Step %d of %d failed: %s. Completed steps are saved in state; apply again to continue with the remaining steps:\n%s",
+						i+1, len(steps), step, describeScalingSteps(steps[i:])))
+			}
+			return diags
+		}
+		step.apply(state)
+	}
+	return diags
+}
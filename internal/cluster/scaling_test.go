@@ -0,0 +1,185 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+)
+
+type scalingFakeStore struct {
+	ClusterStore
+	calls      []string
+	failOnCall int
+}
+
+func (s *scalingFakeStore) record(call string) error {
+	s.calls = append(s.calls, call)
+	if s.failOnCall == len(s.calls) {
+		return errors.New("quota exceeded")
+	}
+	return nil
+}
+
+func (s *scalingFakeStore) UpgradeCuSize(ctx context.Context, clusterId string, cuSize int) error {
+	return s.record(fmt.Sprintf("cu_size=%d", cuSize))
+}
+
+func (s *scalingFakeStore) ModifyReplica(ctx context.Context, clusterId string, replica int) error {
+	return s.record(fmt.Sprintf("replica=%d", replica))
+}
+
+func scalingModel(cuSize, replica int64) ClusterResourceModel {
+	return ClusterResourceModel{
+		ClusterId: types.StringValue("in01-test"),
+		CuSize:    types.Int64Value(cuSize),
+		Replica:   types.Int64Value(replica),
+	}
+}
+
+func TestPlanScalingStepsOrder(t *testing.T) {
+	tests := []struct {
+		name  string
+		plan  ClusterResourceModel
+		state ClusterResourceModel
+		want  []string
+	}{
+		{
+			name:  "no change",
+			plan:  scalingModel(8, 2),
+			state: scalingModel(8, 2),
+			want:  []string{},
+		},
+		{
+			name:  "cu_size only",
+			plan:  scalingModel(16, 2),
+			state: scalingModel(8, 2),
+			want:  []string{"cu_size"},
+		},
+		{
+			name:  "scale up runs cu_size first",
+			plan:  scalingModel(8, 2),
+			state: scalingModel(4, 1),
+			want:  []string{"cu_size", "replica"},
+		},
+		{
+			name:  "scale down runs replica first",
+			plan:  scalingModel(4, 1),
+			state: scalingModel(8, 2),
+			want:  []string{"replica", "cu_size"},
+		},
+		{
+			name:  "unknown replica is skipped",
+			plan:  ClusterResourceModel{CuSize: types.Int64Value(16), Replica: types.Int64Unknown()},
+			state: scalingModel(8, 2),
+			want:  []string{"cu_size"},
+		},
+		{
+			name: "autoscaled cu_size is skipped",
+			plan: ClusterResourceModel{
+				CuSize:     types.Int64Value(16),
+				Replica:    types.Int64Value(3),
+				CuSettings: &CuSettings{DynamicScaling: &DynamicScaling{Min: types.Int64Value(8), Max: types.Int64Value(16)}},
+			},
+			state: scalingModel(8, 2),
+			want:  []string{"replica"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []string{}
+			for _, step := range planScalingSteps(tt.plan, tt.state) {
+				got = append(got, step.attribute)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("steps = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunScalingSteps(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"code":0,"data":{"clusterId":"in01-test","status":"RUNNING"}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := zilliz.NewClient(zilliz.WithApiKey("test-api-key"), zilliz.WithBaseUrl(server.URL))
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		failOnCall  int
+		wantCalls   []string
+		wantCuSize  int64
+		wantReplica int64
+		wantError   bool
+		// remaining is the number of steps planned against the saved state.
+		remaining int
+	}{
+		{
+			name:        "all steps complete",
+			wantCalls:   []string{"cu_size=8", "replica=2"},
+			wantCuSize:  8,
+			wantReplica: 2,
+		},
+		{
+			name:        "second step fails",
+			failOnCall:  2,
+			wantCalls:   []string{"cu_size=8", "replica=2"},
+			wantCuSize:  8,
+			wantReplica: 1,
+			wantError:   true,
+			remaining:   1,
+		},
+		{
+			name:        "first step fails",
+			failOnCall:  1,
+			wantCalls:   []string{"cu_size=8"},
+			wantCuSize:  4,
+			wantReplica: 1,
+			wantError:   true,
+			remaining:   2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &scalingFakeStore{failOnCall: tt.failOnCall}
+			r := &ClusterResource{
+				client:  client,
+				store:   store,
+				timeout: func() time.Duration { return time.Minute },
+			}
+			plan := scalingModel(8, 2)
+			state := scalingModel(4, 1)
+
+			diags := r.runScalingSteps(context.Background(), planScalingSteps(plan, state), plan, &state)
+			if diags.HasError() != tt.wantError {
+				t.Fatalf("HasError = %t, want %t: %s", diags.HasError(), tt.wantError, diags)
+			}
+			if !reflect.DeepEqual(store.calls, tt.wantCalls) {
+				t.Fatalf("calls = %v, want %v", store.calls, tt.wantCalls)
+			}
+			if state.CuSize.ValueInt64() != tt.wantCuSize || state.Replica.ValueInt64() != tt.wantReplica {
+				t.Fatalf("state cu_size=%d replica=%d, want %d/%d", state.CuSize.ValueInt64(), state.Replica.ValueInt64(), tt.wantCuSize, tt.wantReplica)
+			}
+
+			// Planning again against the saved state only leaves the remaining steps.
+			if remaining := planScalingSteps(plan, state); len(remaining) != tt.remaining {
+				t.Fatalf("remaining steps = %v, want %d", remaining, tt.remaining)
+			}
+		})
+	}
+}
@@ -17,13 +17,18 @@ type ClusterStore interface {
 	Delete(ctx context.Context, clusterId string) error
 	UpgradeCuSize(ctx context.Context, clusterId string, cuSize int) error
 	ModifyReplica(ctx context.Context, clusterId string, replica int) error
+	UpgradePlan(ctx context.Context, clusterId string, plan string, cuType string) error
 	SuspendCluster(ctx context.Context, clusterId string) error
 	ResumeCluster(ctx context.Context, clusterId string) error
 	UpdateLabels(ctx context.Context, clusterId string, labels map[string]string) error
-	ModifyClusterProperties(ctx context.Context, clusterId string, clusterName string) error
+	ModifyClusterProperties(ctx context.Context, clusterId string, params *zilliz.ModifyPropertiesParams) error
 	UpsertSecurityGroups(ctx context.Context, clusterId string, securityGroupIds []string) error
 	GetSecurityGroups(ctx context.Context, clusterId string) ([]string, error)
 	ModifyAutoscaling(ctx context.Context, clusterId string, params *zilliz.ModifyAutoscalingCombinedParams) error
+	ResetPassword(ctx context.Context, clusterId string, username string, password string) error
+	UpdateSuspendSchedule(ctx context.Context, clusterId string, schedule *SuspendSchedule) error
+	UpdateMaintenanceWindow(ctx context.Context, clusterId string, window *MaintenanceWindow) error
+	UpgradeVersion(ctx context.Context, clusterId string, version string) error
 }
 
 var _ ClusterStore = (*ClusterStoreImpl)(nil)
@@ -92,6 +97,15 @@ func (c *ClusterStoreImpl) Get(ctx context.Context, clusterId string) (*ClusterR
 		}
 	}
 
+	var suspendSchedule *SuspendSchedule
+	if cluster.SuspendSchedule != nil {
+		suspendSchedule = &SuspendSchedule{
+			SuspendCron: types.StringValue(cluster.SuspendSchedule.SuspendCron),
+			ResumeCron:  types.StringValue(cluster.SuspendSchedule.ResumeCron),
+			Timezone:    types.StringValue(cluster.SuspendSchedule.Timezone),
+		}
+	}
+
 	return &ClusterResourceModel{
 		ClusterId:   types.StringValue(cluster.ClusterId),
 		Plan:        types.StringValue(cluster.Plan),
@@ -130,6 +144,12 @@ func (c *ClusterStoreImpl) Get(ctx context.Context, clusterId string) (*ClusterR
 		AwsCseKeyArn:    types.StringValue(cluster.AwsCseKeyArn),
 		CuSettings:      cuSettings,
 		ReplicaSettings: replicaSettings,
+		SuspendSchedule: suspendSchedule,
+		// The public endpoint is enabled unless the API reports otherwise.
+		PublicEndpointEnabled: types.BoolValue(cluster.PublicEndpointEnabled == nil || *cluster.PublicEndpointEnabled),
+		MaintenanceWindow:     maintenanceWindowFromAPI(cluster.MaintenanceWindow),
+		PendingMaintenance:    pendingMaintenanceValue(cluster.PendingMaintenance),
+		MilvusVersion:         types.StringValue(cluster.MilvusVersion),
 	}, nil
 }
 
@@ -159,12 +179,14 @@ func (c *ClusterStoreImpl) Create(ctx context.Context, cluster *ClusterResourceM
 			RegionId:    regionId,
 			ClusterName: cluster.ClusterName.ValueString(),
 			ProjectId:   cluster.ProjectId.ValueString(),
+			Description: cluster.Description.ValueString(),
 		})
 	case ServerlessPlan:
 		response, err = c.client.CreateServerlessCluster(zilliz.CreateServerlessClusterParams{
 			RegionId:    regionId,
 			ClusterName: cluster.ClusterName.ValueString(),
 			ProjectId:   cluster.ProjectId.ValueString(),
+			Description: cluster.Description.ValueString(),
 		})
 	default:
 
@@ -197,6 +219,15 @@ func (c *ClusterStoreImpl) Create(ctx context.Context, cluster *ClusterResourceM
 			Labels:       labels,
 			BucketInfo:   bucketInfo,
 			AwsCseKeyArn: awsCseKeyArn,
+			Description:  cluster.Description.ValueString(),
+		}
+		// Sent with the create request, so no maintenance is scheduled outside the window.
+		params.MaintenanceWindow = cluster.MaintenanceWindow.toAPI()
+		if cluster.hasTargetVersion() {
+			params.MilvusVersion = cluster.TargetVersion.ValueString()
+		}
+		if !cluster.PublicEndpointEnabled.IsNull() && !cluster.PublicEndpointEnabled.IsUnknown() {
+			params.PublicEndpointEnabled = cluster.PublicEndpointEnabled.ValueBoolPointer()
 		}
 		if cluster.CuSettings != nil && !cluster.CuSettings.IsDynamicScalingNull() {
 			params.Autoscaling = &zilliz.AutoscalingConfig{
@@ -245,6 +276,45 @@ func (c *ClusterStoreImpl) UpgradeCuSize(ctx context.Context, clusterId string,
 	return err
 }
 
+func (c *ClusterStoreImpl) UpgradePlan(ctx context.Context, clusterId string, plan string, cuType string) error {
+	_, err := c.client.ModifyClusterPlan(clusterId, &zilliz.ModifyClusterPlanParams{
+		Plan:   plan,
+		CuType: cuType,
+	})
+	return err
+}
+
+// UpdateSuspendSchedule stores the schedule server-side, or removes it when schedule is nil.
+func (c *ClusterStoreImpl) UpdateSuspendSchedule(ctx context.Context, clusterId string, schedule *SuspendSchedule) error {
+	if schedule == nil {
+		_, err := c.client.DeleteSuspendSchedule(clusterId)
+		return err
+	}
+	_, err := c.client.UpdateSuspendSchedule(clusterId, &zilliz.SuspendSchedule{
+		SuspendCron: schedule.SuspendCron.ValueString(),
+		ResumeCron:  schedule.ResumeCron.ValueString(),
+		Timezone:    schedule.Timezone.ValueString(),
+	})
+	return err
+}
+
+// UpdateMaintenanceWindow stores the window server-side, or removes it when window is nil.
+func (c *ClusterStoreImpl) UpdateMaintenanceWindow(ctx context.Context, clusterId string, window *MaintenanceWindow) error {
+	if window == nil {
+		_, err := c.client.DeleteMaintenanceWindow(clusterId)
+		return err
+	}
+	_, err := c.client.UpdateMaintenanceWindow(clusterId, window.toAPI())
+	return err
+}
+
+func (c *ClusterStoreImpl) UpgradeVersion(ctx context.Context, clusterId string, version string) error {
+	_, err := c.client.UpgradeClusterVersion(clusterId, &zilliz.UpgradeClusterVersionParams{
+		TargetVersion: version,
+	})
+	return err
+}
+
 func (c *ClusterStoreImpl) ModifyReplica(ctx context.Context, clusterId string, replica int) error {
 	_, err := c.client.ModifyReplica(clusterId, &zilliz.ModifyReplicaParams{
 		Replica: replica,
@@ -277,10 +347,8 @@ func (c *ClusterStoreImpl) GetLabels(ctx context.Context, clusterId string) (typ
 	return convertLabelsToTypesMap(labels), nil
 }
 
-func (c *ClusterStoreImpl) ModifyClusterProperties(ctx context.Context, clusterId string, clusterName string) error {
-	_, err := c.client.ModifyClusterProperties(clusterId, &zilliz.ModifyPropertiesParams{
-		ClusterName: clusterName,
-	})
+func (c *ClusterStoreImpl) ModifyClusterProperties(ctx context.Context, clusterId string, params *zilliz.ModifyPropertiesParams) error {
+	_, err := c.client.ModifyClusterProperties(clusterId, params)
 	return err
 }
 
@@ -300,6 +368,16 @@ func (c *ClusterStoreImpl) ModifyAutoscaling(ctx context.Context, clusterId stri
 	return err
 }
 
+// ResetPassword sets the password of a cluster user through the control
+// plane, which does not need the user's current password.
+func (c *ClusterStoreImpl) ResetPassword(ctx context.Context, clusterId string, username string, password string) error {
+	_, err := c.client.ResetClusterPassword(clusterId, &zilliz.ResetClusterPasswordParams{
+		Username: username,
+		Password: password,
+	})
+	return err
+}
+
 // convertLabelsToTypesMap converts a map[string]string into a Terraform types.Map of strings.
 // Returns an empty map value when the input is nil or empty.
 func convertLabelsToTypesMap(src map[string]string) types.Map {
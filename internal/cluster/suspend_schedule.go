@@ -0,0 +1,52 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func (c *ClusterResourceModel) hasSuspendSchedule() bool {
+	return c.SuspendSchedule != nil
+}
+
+// validateSuspendSchedule rejects schedules the API cannot store. Free and
+// Serverless clusters cannot be suspended, and a schedule would resume a
+// cluster that desired_status asks to keep suspended.
+func validateSuspendSchedule(plan ClusterResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !plan.hasSuspendSchedule() {
+		return diags
+	}
+
+	if !plan.Plan.IsUnknown() && (plan.Plan.ValueString() == FreePlan || plan.Plan.ValueString() == ServerlessPlan) {
+		diags.AddAttributeError(path.Root("suspend_schedule"), "Suspend schedule not supported",
+			fmt.Sprintf("%s clusters cannot be suspended, suspend_schedule requires a Standard, Enterprise or BusinessCritical plan.", plan.Plan.ValueString()))
+	}
+
+	if plan.DesiredStatus.ValueString() == "SUSPENDED" {
+		diags.AddAttributeError(path.Root("desired_status"), "Conflicting cluster status",
+			"desired_status cannot be SUSPENDED while suspend_schedule is set, the schedule would resume the cluster. Remove suspend_schedule to keep the cluster suspended.")
+	}
+	return diags
+}
+
+// isScheduledStatusChange reports whether a status transition in Update comes
+// from the configuration. While a suspend schedule is set, the cluster status
+// follows the schedule between applies, so only an edit of desired_status
+// suspends or resumes the cluster.
+func (c *ClusterResourceModel) isScheduledStatusChange(state ClusterResourceModel) bool {
+	return c.hasSuspendSchedule() && c.DesiredStatus.Equal(state.DesiredStatus)
+}
+
+func (r *ClusterResource) handleSuspendScheduleUpdate(ctx context.Context, plan, state ClusterResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := r.store.UpdateSuspendSchedule(ctx, state.ClusterId.ValueString(), plan.SuspendSchedule)
+	if err != nil {
+		diags.AddError("Failed to update cluster suspend schedule", err.Error())
+	}
+	return diags
+}
@@ -0,0 +1,129 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type suspendScheduleFakeStore struct {
+	ClusterStore
+	schedules []*SuspendSchedule
+}
+
+func (s *suspendScheduleFakeStore) UpdateSuspendSchedule(ctx context.Context, clusterId string, schedule *SuspendSchedule) error {
+	s.schedules = append(s.schedules, schedule)
+	return nil
+}
+
+func nightlySchedule() *SuspendSchedule {
+	return &SuspendSchedule{
+		SuspendCron: types.StringValue("0 20 * * 1-5"),
+		ResumeCron:  types.StringValue("0 8 * * 1-5"),
+		Timezone:    types.StringValue("Europe/Berlin"),
+	}
+}
+
+func TestValidateSuspendSchedule(t *testing.T) {
+	tests := []struct {
+		name    string
+		plan    ClusterResourceModel
+		wantErr bool
+	}{
+		{
+			name: "no schedule on free plan",
+			plan: ClusterResourceModel{Plan: types.StringValue(FreePlan), DesiredStatus: types.StringValue("RUNNING")},
+		},
+		{
+			name: "schedule on standard plan",
+			plan: ClusterResourceModel{Plan: types.StringValue(StandardPlan), DesiredStatus: types.StringValue("RUNNING"), SuspendSchedule: nightlySchedule()},
+		},
+		{
+			name: "schedule with unknown plan",
+			plan: ClusterResourceModel{Plan: types.StringUnknown(), DesiredStatus: types.StringValue("RUNNING"), SuspendSchedule: nightlySchedule()},
+		},
+		{
+			name:    "schedule on serverless plan",
+			plan:    ClusterResourceModel{Plan: types.StringValue(ServerlessPlan), DesiredStatus: types.StringValue("RUNNING"), SuspendSchedule: nightlySchedule()},
+			wantErr: true,
+		},
+		{
+			name:    "schedule with desired status suspended",
+			plan:    ClusterResourceModel{Plan: types.StringValue(EnterprisePlan), DesiredStatus: types.StringValue("SUSPENDED"), SuspendSchedule: nightlySchedule()},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateSuspendSchedule(tt.plan)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("HasError = %t, want %t: %s", diags.HasError(), tt.wantErr, diags)
+			}
+		})
+	}
+}
+
+func TestIsScheduledStatusChange(t *testing.T) {
+	running := types.StringValue("RUNNING")
+	suspended := types.StringValue("SUSPENDED")
+
+	// Suspended by the schedule since the last apply.
+	state := ClusterResourceModel{DesiredStatus: running, Status: suspended, SuspendSchedule: nightlySchedule()}
+
+	plan := ClusterResourceModel{DesiredStatus: running, SuspendSchedule: nightlySchedule()}
+	if !plan.isScheduledStatusChange(state) {
+		t.Fatal("status set by the schedule should be left alone")
+	}
+	if !plan.isStatusChangeRequired(state) {
+		t.Fatal("without the schedule the cluster would be resumed")
+	}
+
+	plan.SuspendSchedule = nil
+	if plan.isScheduledStatusChange(state) {
+		t.Fatal("removing the schedule should hand the status back to desired_status")
+	}
+
+	// Turning a manually suspended cluster over to the schedule resumes it.
+	state = ClusterResourceModel{DesiredStatus: suspended, Status: suspended}
+	plan = ClusterResourceModel{DesiredStatus: running, SuspendSchedule: nightlySchedule()}
+	if plan.isScheduledStatusChange(state) {
+		t.Fatal("an edited desired_status should be applied")
+	}
+}
+
+func TestSuspendScheduleEqual(t *testing.T) {
+	var none *SuspendSchedule
+	if !none.Equal(nil) {
+		t.Fatal("nil schedules should be equal")
+	}
+	if none.Equal(nightlySchedule()) || nightlySchedule().Equal(nil) {
+		t.Fatal("nil and set schedules should differ")
+	}
+	if !nightlySchedule().Equal(nightlySchedule()) {
+		t.Fatal("identical schedules should be equal")
+	}
+	other := nightlySchedule()
+	other.Timezone = types.StringValue("Etc/UTC")
+	if nightlySchedule().Equal(other) {
+		t.Fatal("schedules in different timezones should differ")
+	}
+}
+
+func TestHandleSuspendScheduleUpdate(t *testing.T) {
+	store := &suspendScheduleFakeStore{}
+	r := &ClusterResource{store: store}
+	state := ClusterResourceModel{ClusterId: types.StringValue("in01-test")}
+
+	if diags := r.handleSuspendScheduleUpdate(context.Background(), ClusterResourceModel{SuspendSchedule: nightlySchedule()}, state); diags.HasError() {
+		t.Fatalf("store schedule: %s", diags)
+	}
+	if diags := r.handleSuspendScheduleUpdate(context.Background(), ClusterResourceModel{}, state); diags.HasError() {
+		t.Fatalf("remove schedule: %s", diags)
+	}
+
+	if len(store.schedules) != 2 || !store.schedules[0].Equal(nightlySchedule()) || store.schedules[1] != nil {
+		t.Fatalf("stored schedules = %v, want the schedule then a removal", store.schedules)
+	}
+}
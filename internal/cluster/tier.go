@@ -0,0 +1,103 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	util "github.com/zilliztech/terraform-provider-zillizcloud/client/retry"
+)
+
+// dedicatedPlanRank orders the dedicated plan tiers. A plan can only be
+// upgraded in place to a tier with a higher rank.
+var dedicatedPlanRank = map[string]int{
+	StandardPlan:         1,
+	EnterprisePlan:       2,
+	BusinessCriticalPlan: 3,
+}
+
+// cuTypeMigrations lists the cu_type values a dedicated cluster can migrate to
+// in place.
+var cuTypeMigrations = map[string][]string{
+	"Performance-optimized": {"Capacity-optimized", "Tiered-storage"},
+	"Capacity-optimized":    {"Performance-optimized", "Tiered-storage"},
+}
+
+func (c *ClusterResourceModel) isPlanChanged(other ClusterResourceModel) bool {
+	return !c.Plan.IsUnknown() && !c.Plan.IsNull() && c.Plan.ValueString() != other.Plan.ValueString()
+}
+
+func (c *ClusterResourceModel) isCuTypeChanged(other ClusterResourceModel) bool {
+	return !c.CuType.IsUnknown() && !c.CuType.IsNull() && c.CuType.ValueString() != other.CuType.ValueString()
+}
+
+// validateTierTransition rejects plan and cu_type changes that cannot be
+// applied in place, so the plan never shows a change Update cannot make.
+func validateTierTransition(plan, state ClusterResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	from, to := state.Plan.ValueString(), plan.Plan.ValueString()
+	if plan.isPlanChanged(state) {
+		fromRank, fromDedicated := dedicatedPlanRank[from]
+		toRank, toDedicated := dedicatedPlanRank[to]
+		switch {
+		case !fromDedicated || !toDedicated:
+			diags.AddAttributeError(path.Root("plan"), "Unsupported plan change",
+				fmt.Sprintf("Cannot change plan from %s to %s in place. Only dedicated clusters can be upgraded (%s -> %s -> %s); create a new cluster and migrate the data instead.",
+					from, to, StandardPlan, EnterprisePlan, BusinessCriticalPlan))
+		case toRank < fromRank:
+			diags.AddAttributeError(path.Root("plan"), "Unsupported plan change",
+				fmt.Sprintf("Cannot downgrade plan from %s to %s. Plans can only be upgraded in place.", from, to))
+		}
+	}
+
+	if plan.isCuTypeChanged(state) {
+		fromType, toType := state.CuType.ValueString(), plan.CuType.ValueString()
+		allowed := cuTypeMigrations[fromType]
+		targetPlan := from
+		if plan.isPlanChanged(state) {
+			targetPlan = to
+		}
+		if _, dedicated := dedicatedPlanRank[targetPlan]; !dedicated {
+			diags.AddAttributeError(path.Root("cu_type"), "Unsupported cu_type change",
+				fmt.Sprintf("Cannot change cu_type of a %s cluster.", targetPlan))
+		} else if !slices.Contains(allowed, toType) {
+			detail := fmt.Sprintf("Cannot migrate cu_type from %s to %s in place.", fromType, toType)
+			if len(allowed) > 0 {
+				detail += fmt.Sprintf(" Supported targets: %s.", strings.Join(allowed, ", "))
+			}
+			diags.AddAttributeError(path.Root("cu_type"), "Unsupported cu_type change", detail)
+		}
+	}
+
+	return diags
+}
+
+// handleTierUpdate upgrades the plan and migrates the cu_type in one request,
+// then waits for the cluster to be RUNNING again.
+func (r *ClusterResource) handleTierUpdate(ctx context.Context, plan, state ClusterResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var newPlan, newCuType string
+	if plan.isPlanChanged(state) {
+		newPlan = plan.Plan.ValueString()
+	}
+	if plan.isCuTypeChanged(state) {
+		newCuType = plan.CuType.ValueString()
+	}
+
+	err := r.store.UpgradePlan(ctx, state.ClusterId.ValueString(), newPlan, newCuType)
+	if err != nil {
+		diags.AddError("Failed to upgrade cluster plan", err.Error())
+		return diags
+	}
+
+	err = r.waitForStatus(ctx, r.timeout(), state.ClusterId.ValueString(), "RUNNING")
+	if err != nil && !util.IsNetworkGiveUpError(err) {
+		diags.AddError("Failed to wait for cluster to enter RUNNING state", err.Error())
+	}
+	return diags
+}
@@ -0,0 +1,141 @@
+package cluster
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+)
+
+type tierFakeStore struct {
+	ClusterStore
+	plan   string
+	cuType string
+	calls  int
+}
+
+func (s *tierFakeStore) UpgradePlan(ctx context.Context, clusterId string, plan string, cuType string) error {
+	s.calls++
+	s.plan = plan
+	s.cuType = cuType
+	return nil
+}
+
+func tierModel(plan, cuType string) ClusterResourceModel {
+	return ClusterResourceModel{
+		ClusterId: types.StringValue("in01-test"),
+		Plan:      types.StringValue(plan),
+		CuType:    types.StringValue(cuType),
+	}
+}
+
+func TestValidateTierTransition(t *testing.T) {
+	tests := []struct {
+		name    string
+		plan    ClusterResourceModel
+		state   ClusterResourceModel
+		wantErr bool
+	}{
+		{
+			name:  "no change",
+			plan:  tierModel(StandardPlan, "Performance-optimized"),
+			state: tierModel(StandardPlan, "Performance-optimized"),
+		},
+		{
+			name:  "standard to enterprise",
+			plan:  tierModel(EnterprisePlan, "Performance-optimized"),
+			state: tierModel(StandardPlan, "Performance-optimized"),
+		},
+		{
+			name:  "standard to business critical",
+			plan:  tierModel(BusinessCriticalPlan, "Performance-optimized"),
+			state: tierModel(StandardPlan, "Performance-optimized"),
+		},
+		{
+			name:    "enterprise to standard",
+			plan:    tierModel(StandardPlan, "Performance-optimized"),
+			state:   tierModel(EnterprisePlan, "Performance-optimized"),
+			wantErr: true,
+		},
+		{
+			name:    "free to standard",
+			plan:    tierModel(StandardPlan, "Performance-optimized"),
+			state:   tierModel(FreePlan, "Performance-optimized"),
+			wantErr: true,
+		},
+		{
+			name:    "standard to serverless",
+			plan:    tierModel(ServerlessPlan, "Performance-optimized"),
+			state:   tierModel(StandardPlan, "Performance-optimized"),
+			wantErr: true,
+		},
+		{
+			name:  "unknown plan is kept",
+			plan:  ClusterResourceModel{Plan: types.StringUnknown(), CuType: types.StringValue("Capacity-optimized")},
+			state: tierModel(EnterprisePlan, "Performance-optimized"),
+		},
+		{
+			name:  "performance to capacity",
+			plan:  tierModel(EnterprisePlan, "Capacity-optimized"),
+			state: tierModel(EnterprisePlan, "Performance-optimized"),
+		},
+		{
+			name:  "capacity to tiered storage",
+			plan:  tierModel(EnterprisePlan, "Tiered-storage"),
+			state: tierModel(EnterprisePlan, "Capacity-optimized"),
+		},
+		{
+			name:    "tiered storage to performance",
+			plan:    tierModel(EnterprisePlan, "Performance-optimized"),
+			state:   tierModel(EnterprisePlan, "Tiered-storage"),
+			wantErr: true,
+		},
+		{
+			name:    "cu_type on serverless",
+			plan:    tierModel(ServerlessPlan, "Capacity-optimized"),
+			state:   tierModel(ServerlessPlan, "Performance-optimized"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateTierTransition(tt.plan, tt.state)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("HasError = %t, want %t: %s", diags.HasError(), tt.wantErr, diags)
+			}
+		})
+	}
+}
+
+func TestHandleTierUpdateSendsChangedFieldsOnly(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"code":0,"data":{"clusterId":"in01-test","status":"RUNNING"}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := zilliz.NewClient(zilliz.WithApiKey("test-api-key"), zilliz.WithBaseUrl(server.URL))
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	store := &tierFakeStore{}
+	r := &ClusterResource{
+		client:  client,
+		store:   store,
+		timeout: func() time.Duration { return time.Minute },
+	}
+
+	diags := r.handleTierUpdate(context.Background(), tierModel(BusinessCriticalPlan, "Performance-optimized"), tierModel(EnterprisePlan, "Performance-optimized"))
+	if diags.HasError() {
+		t.Fatalf("handleTierUpdate diagnostics: %s", diags)
+	}
+	if store.calls != 1 || store.plan != BusinessCriticalPlan || store.cuType != "" {
+		t.Fatalf("UpgradePlan calls=%d plan=%q cuType=%q, want one call with plan only", store.calls, store.plan, store.cuType)
+	}
+}
@@ -0,0 +1,128 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+	util "github.com/zilliztech/terraform-provider-zillizcloud/client/retry"
+	"github.com/zilliztech/terraform-provider-zillizcloud/internal/util/version"
+)
+
+func (c *ClusterResourceModel) hasTargetVersion() bool {
+	return !c.TargetVersion.IsNull() && !c.TargetVersion.IsUnknown() && c.TargetVersion.ValueString() != ""
+}
+
+// isVersionUpgradeRequired reports whether target_version changed to a version
+// the cluster does not run yet. Reaching the target through a maintenance
+// upgrade, or removing target_version, needs no upgrade.
+func (c *ClusterResourceModel) isVersionUpgradeRequired(other ClusterResourceModel) bool {
+	if !c.hasTargetVersion() || c.TargetVersion.ValueString() == other.TargetVersion.ValueString() {
+		return false
+	}
+	return !version.Same(c.TargetVersion.ValueString(), other.MilvusVersion.ValueString())
+}
+
+// validateVersionUpgrade rejects target_version on Free and Serverless
+// clusters, whose version is managed by Zilliz Cloud, and changes to a version
+// lower than the one the cluster runs, as Milvus cannot be downgraded in place.
+func validateVersionUpgrade(plan, state ClusterResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !plan.hasTargetVersion() {
+		return diags
+	}
+
+	if planValue := plan.Plan.ValueString(); !plan.Plan.IsUnknown() && (planValue == FreePlan || planValue == ServerlessPlan) {
+		diags.AddAttributeError(path.Root("target_version"), "Version pinning not supported",
+			fmt.Sprintf("%s clusters are upgraded by Zilliz Cloud, target_version requires a Standard, Enterprise or BusinessCritical plan.", planValue))
+		return diags
+	}
+
+	if !plan.isVersionUpgradeRequired(state) {
+		return diags
+	}
+	from, to := state.MilvusVersion.ValueString(), plan.TargetVersion.ValueString()
+	if cmp, ok := version.Compare(to, from); ok && cmp < 0 {
+		diags.AddAttributeError(path.Root("target_version"), "Unsupported version change",
+			fmt.Sprintf("Cannot downgrade Milvus from %s to %s. Versions can only be upgraded in place; create a new cluster and migrate the data instead.", from, to))
+	}
+	return diags
+}
+
+// validateAvailableVersion checks target_version against the versions
+// available in the region. An empty list is not checked, the API remains the
+// final authority then.
+func validateAvailableVersion(plan ClusterResourceModel, versions []zilliz.MilvusVersion) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !plan.hasTargetVersion() || len(versions) == 0 {
+		return diags
+	}
+
+	target := plan.TargetVersion.ValueString()
+	available := make([]string, 0, len(versions))
+	for _, v := range versions {
+		if version.Same(v.Version, target) {
+			return diags
+		}
+		available = append(available, v.Version)
+	}
+	diags.AddAttributeError(path.Root("target_version"), "Version not available in region",
+		fmt.Sprintf("Milvus %s is not available in region %s. Available versions: %s.",
+			target, plan.RegionId.ValueString(), strings.Join(available, ", ")))
+	return diags
+}
+
+// listMilvusVersions lists the versions available in the planned region.
+// Lookup failures are logged and skip the check rather than blocking the plan.
+func (r *ClusterResource) listMilvusVersions(ctx context.Context, plan ClusterResourceModel) []zilliz.MilvusVersion {
+	regionId := r.plannedRegionId(plan)
+	if regionId == "" {
+		return nil
+	}
+
+	versions, err := r.client.ListMilvusVersions(regionId)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to list Milvus versions, skipping version checks", map[string]interface{}{
+			"region_id": regionId,
+			"error":     err.Error(),
+		})
+		return nil
+	}
+	return versions
+}
+
+// handleVersionUpgrade upgrades the cluster to target_version, then waits for
+// the cluster to run that version.
+func (r *ClusterResource) handleVersionUpgrade(ctx context.Context, plan, state ClusterResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	clusterId := state.ClusterId.ValueString()
+	target := plan.TargetVersion.ValueString()
+	err := r.store.UpgradeVersion(ctx, clusterId, target)
+	if err != nil {
+		diags.AddError("Failed to upgrade cluster version", err.Error())
+		return diags
+	}
+
+	_, err = util.NetworkResilientPoll(ctx, r.timeout(), func() (*string, *util.Err) {
+		cluster, err := r.client.DescribeCluster(clusterId)
+		if err != nil {
+			return nil, &util.Err{Err: err, Halt: false}
+		}
+		if cluster.Status != "RUNNING" || !version.Same(cluster.MilvusVersion, target) {
+			return nil, &util.Err{
+				Err:  fmt.Errorf("cluster not yet running Milvus %s. Current version: %s, state: %s", target, cluster.MilvusVersion, cluster.Status),
+				Halt: false,
+			}
+		}
+		return &cluster.MilvusVersion, nil
+	}, util.DefaultMaxNetworkFailures)
+	if err != nil && !util.IsNetworkGiveUpError(err) {
+		diags.AddError(fmt.Sprintf("Failed to wait for cluster to run Milvus %s", target), err.Error())
+	}
+	return diags
+}
@@ -0,0 +1,134 @@
+package cluster
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+)
+
+type versionFakeStore struct {
+	ClusterStore
+	versions []string
+}
+
+func (s *versionFakeStore) UpgradeVersion(ctx context.Context, clusterId string, version string) error {
+	s.versions = append(s.versions, version)
+	return nil
+}
+
+func versionModel(plan, milvusVersion, targetVersion string) ClusterResourceModel {
+	m := ClusterResourceModel{
+		ClusterId:     types.StringValue("in01-test"),
+		Plan:          types.StringValue(plan),
+		MilvusVersion: types.StringValue(milvusVersion),
+		TargetVersion: types.StringNull(),
+	}
+	if targetVersion != "" {
+		m.TargetVersion = types.StringValue(targetVersion)
+	}
+	return m
+}
+
+func TestIsVersionUpgradeRequired(t *testing.T) {
+	tests := []struct {
+		name  string
+		plan  ClusterResourceModel
+		state ClusterResourceModel
+		want  bool
+	}{
+		{name: "no target", plan: versionModel(EnterprisePlan, "2.4.17", ""), state: versionModel(EnterprisePlan, "2.4.17", "")},
+		{name: "new target", plan: versionModel(EnterprisePlan, "2.4.17", "2.5.4"), state: versionModel(EnterprisePlan, "2.4.17", ""), want: true},
+		{name: "unchanged target", plan: versionModel(EnterprisePlan, "2.4.17", "2.5.4"), state: versionModel(EnterprisePlan, "2.4.17", "2.5.4")},
+		{name: "target already running", plan: versionModel(EnterprisePlan, "2.5.4", "v2.5.4"), state: versionModel(EnterprisePlan, "2.5.4", "")},
+		{name: "target removed", plan: versionModel(EnterprisePlan, "2.5.4", ""), state: versionModel(EnterprisePlan, "2.5.4", "2.5.4")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.plan.isVersionUpgradeRequired(tt.state); got != tt.want {
+				t.Fatalf("isVersionUpgradeRequired = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateVersionUpgrade(t *testing.T) {
+	tests := []struct {
+		name    string
+		plan    ClusterResourceModel
+		state   ClusterResourceModel
+		wantErr bool
+	}{
+		{name: "upgrade", plan: versionModel(EnterprisePlan, "2.4.17", "2.5.4"), state: versionModel(EnterprisePlan, "2.4.17", "")},
+		{name: "new cluster", plan: versionModel(EnterprisePlan, "", "2.5.4"), state: ClusterResourceModel{}},
+		{name: "unparseable version", plan: versionModel(EnterprisePlan, "2.5.4", "latest"), state: versionModel(EnterprisePlan, "2.5.4", "")},
+		{name: "downgrade", plan: versionModel(EnterprisePlan, "2.5.4", "2.4.17"), state: versionModel(EnterprisePlan, "2.5.4", ""), wantErr: true},
+		{name: "unchanged target below upgraded cluster", plan: versionModel(EnterprisePlan, "2.5.4", "2.4.17"), state: versionModel(EnterprisePlan, "2.5.4", "2.4.17")},
+		{name: "serverless", plan: versionModel(ServerlessPlan, "", "2.5.4"), state: ClusterResourceModel{}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateVersionUpgrade(tt.plan, tt.state)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("HasError = %t, want %t: %s", diags.HasError(), tt.wantErr, diags)
+			}
+		})
+	}
+}
+
+func TestValidateAvailableVersion(t *testing.T) {
+	versions := []zilliz.MilvusVersion{{Version: "2.4.17"}, {Version: "2.5.4", IsDefault: true}}
+
+	if diags := validateAvailableVersion(versionModel(EnterprisePlan, "2.4.17", "2.5.4"), versions); diags.HasError() {
+		t.Fatalf("available version: %s", diags)
+	}
+	if diags := validateAvailableVersion(versionModel(EnterprisePlan, "2.4.17", "2.6.0"), nil); diags.HasError() {
+		t.Fatalf("unknown region versions: %s", diags)
+	}
+	if diags := validateAvailableVersion(versionModel(EnterprisePlan, "2.4.17", "2.6.0"), versions); !diags.HasError() {
+		t.Fatal("want an error for a version the region does not offer")
+	}
+}
+
+func TestHandleVersionUpgradeWaitsForTargetVersion(t *testing.T) {
+	describes := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		describes++
+		w.Header().Set("Content-Type", "application/json")
+		if describes == 1 {
+			_, _ = w.Write([]byte(`{"code":0,"data":{"clusterId":"in01-test","status":"RUNNING","milvusVersion":"2.4.17"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"code":0,"data":{"clusterId":"in01-test","status":"RUNNING","milvusVersion":"2.5.4"}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := zilliz.NewClient(zilliz.WithApiKey("test-api-key"), zilliz.WithBaseUrl(server.URL))
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	store := &versionFakeStore{}
+	r := &ClusterResource{
+		client:  client,
+		store:   store,
+		timeout: func() time.Duration { return time.Minute },
+	}
+
+	diags := r.handleVersionUpgrade(context.Background(), versionModel(EnterprisePlan, "2.4.17", "2.5.4"), versionModel(EnterprisePlan, "2.4.17", ""))
+	if diags.HasError() {
+		t.Fatalf("handleVersionUpgrade diagnostics: %s", diags)
+	}
+	if len(store.versions) != 1 || store.versions[0] != "2.5.4" {
+		t.Fatalf("UpgradeVersion calls = %v, want one call with 2.5.4", store.versions)
+	}
+	if describes < 2 {
+		t.Fatalf("cluster described %d times, want to wait until it runs 2.5.4", describes)
+	}
+}
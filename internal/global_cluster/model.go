@@ -107,6 +107,18 @@ func (c *GlobalCluster) isCUUpdated(targetCUSize int64) (bool, string, error) {
 	return true, "RUNNING", nil
 }
 
+func (c *GlobalCluster) isAllMembersRunning() (bool, string, error) {
+	if c == nil || len(c.Clusters) == 0 {
+		return false, "missing", nil
+	}
+	for _, member := range c.Clusters {
+		if !member.isRunning() {
+			return false, fmt.Sprintf("%s=%s", member.ClusterName, member.Status), nil
+		}
+	}
+	return true, "RUNNING", nil
+}
+
 func (c *GlobalCluster) isPrimaryMemberRunning() (bool, string, error) {
 	if c == nil {
 		return false, "missing", nil
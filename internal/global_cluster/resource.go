@@ -7,9 +7,11 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -24,6 +26,7 @@ var (
 	globalClusterSecondaryPollInterval   = 10 * time.Second
 	globalClusterSecondaryDeleteTimeout  = 30 * time.Minute
 	globalClusterSecondaryRunningTimeout = 30 * time.Minute
+	globalClusterCreateRunningTimeout    = 45 * time.Minute
 )
 
 var (
@@ -43,6 +46,7 @@ type GlobalClusterResource struct {
 	sureGlobalClusterCU   func(globalClusterID string, targetCUSize int64) condition
 	sureInstanceIsRunning func(globalClusterID string, member GlobalClusterMemberSpec) condition
 	surePrimaryRunning    func(globalClusterID string) condition
+	sureAllMembersRunning func(globalClusterID string) condition
 }
 
 func newGlobalClusterResource() *GlobalClusterResource {
@@ -83,6 +87,15 @@ func newGlobalClusterResource() *GlobalClusterResource {
 			return globalCluster.isPrimaryMemberRunning()
 		}
 	}
+	r.sureAllMembersRunning = func(globalClusterID string) condition {
+		return func(ctx context.Context) (bool, string, error) {
+			globalCluster, err := r.store.Describe(ctx, globalClusterID)
+			if err != nil {
+				return false, "", err
+			}
+			return globalCluster.isAllMembersRunning()
+		}
+	}
 
 	return r
 }
@@ -180,6 +193,12 @@ func (r *GlobalClusterResource) Schema(ctx context.Context, req resource.SchemaR
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"wait_for_ready": schema.BoolAttribute{
+				MarkdownDescription: "Whether creation waits, for up to 45 minutes, for every member cluster to reach RUNNING. By default creation returns once the global cluster and member IDs are known; use the `zillizcloud_cluster_ready` data source on the members that need to be running. If the wait fails, the global cluster is kept and a warning is reported. Defaults to false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -271,7 +290,48 @@ func (r *GlobalClusterResource) Create(ctx context.Context, req resource.CreateR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if !data.waitsForReady() {
+		return
+	}
+	if running, _, _ := globalCluster.isAllMembersRunning(); running {
+		return
+	}
+	if err := waitFor(
+		ctx,
+		globalClusterCreateRunningTimeout,
+		globalClusterSecondaryPollInterval,
+		r.sureAllMembersRunning(created.GlobalClusterID),
+		func(lastStatus string) error {
+			return fmt.Errorf("global cluster members did not reach RUNNING status; last status %s", lastStatus)
+		},
+	); err != nil {
+		// The global cluster exists and is already in state. An error would taint
+		// it and the next apply would recreate it, so only warn.
+		resp.Diagnostics.AddWarning(
+			"Global cluster created but not all members are RUNNING",
+			fmt.Sprintf("global_cluster_id=%s error=%s", created.GlobalClusterID, err.Error()),
+		)
+		return
+	}
 
+	globalCluster, err = r.store.Describe(ctx, created.GlobalClusterID)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Failed to read global cluster after create",
+			fmt.Sprintf("global_cluster_id=%s error=%s", created.GlobalClusterID, err.Error()),
+		)
+		return
+	}
+	var diags diag.Diagnostics
+	data.applyGlobalCluster(ctx, globalCluster, diags.Append)
+	for _, d := range diags.Errors() {
+		resp.Diagnostics.AddWarning(d.Summary(), d.Detail())
+	}
+	if diags.HasError() {
+		return
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -299,6 +359,9 @@ func (r *GlobalClusterResource) Read(ctx context.Context, req resource.ReadReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if state.WaitForReady.IsNull() {
+		state.WaitForReady = types.BoolValue(false)
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -20,6 +20,7 @@ type GlobalClusterResourceModel struct {
 	Username          types.String               `tfsdk:"username"`
 	Password          types.String               `tfsdk:"password"`
 	CreateJobID       types.String               `tfsdk:"create_job_id"`
+	WaitForReady      types.Bool                 `tfsdk:"wait_for_ready"`
 }
 
 type GlobalClusterMemberModel struct {
@@ -52,6 +53,12 @@ func (data *GlobalClusterResourceModel) applyGlobalCluster(ctx context.Context,
 	data.Cluster = globalClusterMembersFromDomain(globalCluster.Clusters, data.Cluster)
 }
 
+// waitsForReady reports whether creation waits for every member to reach
+// RUNNING, which only happens when wait_for_ready is set to true.
+func (data GlobalClusterResourceModel) waitsForReady() bool {
+	return data.WaitForReady.ValueBool()
+}
+
 func (data GlobalClusterResourceModel) memberSpecs() []GlobalClusterMemberSpec {
 	specs := make([]GlobalClusterMemberSpec, 0, len(data.Cluster))
 	for _, member := range data.Cluster {
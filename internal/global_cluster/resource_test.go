@@ -431,6 +431,129 @@ func TestGlobalClusterResourceCreateDelegatesMemberValidationToBackend(t *testin
 	}
 }
 
+func testGlobalClusterCreatePlan(t *testing.T, ctx context.Context, schema rschema.Schema, waitForReady bool) tfsdk.Plan {
+	t.Helper()
+	model := testGlobalClusterBaseModel()
+	model.ID = types.StringUnknown()
+	for i := range model.Cluster {
+		model.Cluster[i].ClusterID = types.StringUnknown()
+		model.Cluster[i].Role = types.StringUnknown()
+		model.Cluster[i].Status = types.StringUnknown()
+	}
+	model.ConnectAddress = types.StringUnknown()
+	model.CreateTime = types.StringUnknown()
+	model.RegionIDs = types.ListUnknown(types.StringType)
+	model.Username = types.StringUnknown()
+	model.Password = types.StringUnknown()
+	model.CreateJobID = types.StringUnknown()
+	model.WaitForReady = types.BoolValue(waitForReady)
+	return testGlobalClusterPlan(t, ctx, schema, model)
+}
+
+func globalClusterCreatedResponse(t *testing.T) *http.Response {
+	t.Helper()
+	return globalClusterJSONResponse(t, http.StatusOK, map[string]any{
+		"code": 0,
+		"data": map[string]any{"globalClusterId": "glo-1", "username": "db_admin", "password": "password", "jobId": "job-create-1"},
+	})
+}
+
+func TestGlobalClusterResourceCreateWaitsForMembersRunning(t *testing.T) {
+	ctx := context.Background()
+	testGlobalClusterPostCreateDescribeDelay(t, 0)
+	testGlobalClusterSecondaryRunningWait(t, time.Millisecond, time.Second)
+	resource := newTestGlobalClusterResource(t, func(call int, req *http.Request, body []byte) (*http.Response, error) {
+		switch call {
+		case 1:
+			return globalClusterCreatedResponse(t), nil
+		case 2, 3:
+			return globalClusterJSONResponse(t, http.StatusOK, describeGlobalClusterPayloadWithSecondaryAPCreating(4)), nil
+		case 4, 5:
+			return globalClusterJSONResponse(t, http.StatusOK, describeGlobalClusterPayload(4)), nil
+		default:
+			return nil, fmt.Errorf("unexpected call %d", call)
+		}
+	})
+	schema := testGlobalClusterResourceSchema(t, resource)
+
+	var resp fwresource.CreateResponse
+	resp.State = tfsdk.State{Schema: schema}
+	resource.Create(ctx, fwresource.CreateRequest{Plan: testGlobalClusterCreatePlan(t, ctx, schema, true)}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create diagnostics: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+
+	var state GlobalClusterResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if state.Cluster[2].Status.ValueString() != "RUNNING" {
+		t.Fatalf("secondary-ap status=%s, want RUNNING", state.Cluster[2].Status.ValueString())
+	}
+}
+
+func TestGlobalClusterResourceCreateWarnsWhenMembersNeverRun(t *testing.T) {
+	ctx := context.Background()
+	testGlobalClusterPostCreateDescribeDelay(t, 0)
+	testGlobalClusterSecondaryRunningWait(t, time.Millisecond, time.Second)
+	originalTimeout := globalClusterCreateRunningTimeout
+	globalClusterCreateRunningTimeout = 20 * time.Millisecond
+	t.Cleanup(func() { globalClusterCreateRunningTimeout = originalTimeout })
+	resource := newTestGlobalClusterResource(t, func(call int, req *http.Request, body []byte) (*http.Response, error) {
+		if call == 1 {
+			return globalClusterCreatedResponse(t), nil
+		}
+		return globalClusterJSONResponse(t, http.StatusOK, describeGlobalClusterPayloadWithSecondaryAPCreating(4)), nil
+	})
+	schema := testGlobalClusterResourceSchema(t, resource)
+
+	var resp fwresource.CreateResponse
+	resp.State = tfsdk.State{Schema: schema}
+	resource.Create(ctx, fwresource.CreateRequest{Plan: testGlobalClusterCreatePlan(t, ctx, schema, true)}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create diagnostics: %s, want only a warning so the global cluster is not tainted", resp.Diagnostics.Errors()[0].Detail())
+	}
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Fatalf("warnings = %d, want one for the members that are not running", resp.Diagnostics.WarningsCount())
+	}
+
+	var state GlobalClusterResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if state.ID.ValueString() != "glo-1" {
+		t.Fatalf("id=%s, want the created global cluster kept in state", state.ID.ValueString())
+	}
+}
+
+func TestGlobalClusterResourceCreateWithoutWaitForReady(t *testing.T) {
+	ctx := context.Background()
+	testGlobalClusterPostCreateDescribeDelay(t, 0)
+	resource := newTestGlobalClusterResource(t, func(call int, req *http.Request, body []byte) (*http.Response, error) {
+		switch call {
+		case 1:
+			return globalClusterCreatedResponse(t), nil
+		case 2:
+			return globalClusterJSONResponse(t, http.StatusOK, describeGlobalClusterPayloadWithSecondaryAPCreating(4)), nil
+		default:
+			return nil, fmt.Errorf("unexpected call %d", call)
+		}
+	})
+	schema := testGlobalClusterResourceSchema(t, resource)
+
+	var resp fwresource.CreateResponse
+	resp.State = tfsdk.State{Schema: schema}
+	resource.Create(ctx, fwresource.CreateRequest{Plan: testGlobalClusterCreatePlan(t, ctx, schema, false)}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create diagnostics: %s", resp.Diagnostics.Errors()[0].Detail())
+	}
+
+	var state GlobalClusterResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if state.ID.ValueString() != "glo-1" || state.Cluster[2].ClusterID.ValueString() != "in01-secondary-ap" {
+		t.Fatalf("state not hydrated: id=%s members=%+v", state.ID.ValueString(), state.Cluster)
+	}
+	if state.Cluster[2].Status.ValueString() != "CREATING" {
+		t.Fatalf("secondary-ap status=%s, want CREATING", state.Cluster[2].Status.ValueString())
+	}
+}
+
 func TestGlobalClusterResourceUpdateModifiesCU(t *testing.T) {
 	ctx := context.Background()
 	resource := newTestGlobalClusterResource(t, func(call int, req *http.Request, body []byte) (*http.Response, error) {
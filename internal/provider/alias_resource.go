@@ -39,7 +39,8 @@ func (r *AliasResource) Metadata(ctx context.Context, req resource.MetadataReque
 func (r *AliasResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: `Manages a collection alias in a Zilliz Cloud database.
-Changing any field will force resource replacement as alias updates require drop and recreate operations.`,
+Changing ` + "`collection_name`" + ` retargets the alias in place, so clients using the alias switch collections without downtime.
+Changing any other field will force resource replacement.`,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -88,10 +89,7 @@ You can obtain this value from the output of the ` + "`zillizcloud_cluster`" + `
 			},
 			"collection_name": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: `The name of the collection to which the alias points.`,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				MarkdownDescription: `The name of the collection to which the alias points. Updated in place with the alter aliases API.`,
 			},
 		},
 	}
@@ -177,7 +175,7 @@ func (r *AliasResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	// Check if alias exists
-	_, err = client.DescribeAlias(&zilliz.DescribeAliasParams{
+	alias, err := client.DescribeAlias(&zilliz.DescribeAliasParams{
 		DbName:    data.DbName.ValueString(),
 		AliasName: data.AliasName.ValueString(),
 	})
@@ -188,6 +186,7 @@ func (r *AliasResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		)
 		return
 	}
+	data.CollectionName = types.StringValue(alias.CollectionName)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...) // Save state
 }
@@ -243,7 +242,7 @@ func (r *AliasResource) ImportState(ctx context.Context, req resource.ImportStat
 		return
 	}
 
-	_, err = client.DescribeAlias(&zilliz.DescribeAliasParams{
+	alias, err := client.DescribeAlias(&zilliz.DescribeAliasParams{
 		DbName:    dbName,
 		AliasName: aliasName,
 	})
@@ -260,27 +259,22 @@ func (r *AliasResource) ImportState(ctx context.Context, req resource.ImportStat
 		ConnectAddress: types.StringValue(connectAddressFull),
 		DbName:         types.StringValue(dbName),
 		AliasName:      types.StringValue(aliasName),
-		CollectionName: types.StringValue(""), // Not available from import
+		CollectionName: types.StringValue(alias.CollectionName),
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...) // Save state
 }
 
-// Update method: implements drop + create pattern for alias updates.
+// Update retargets the alias with AlterAliases; every other attribute forces replacement.
 func (r *AliasResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var state AliasResourceModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...) // Old state
-	if resp.Diagnostics.HasError() {
-		return
-	}
 	var plan AliasResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...) // New plan
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	connectAddress := state.ConnectAddress.ValueString()
-	client, err := r.client.Collection(connectAddress, state.DbName.ValueString())
+	connectAddress := plan.ConnectAddress.ValueString()
+	client, err := r.client.Collection(connectAddress, plan.DbName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to get collection client",
@@ -289,45 +283,19 @@ func (r *AliasResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	// Step 1: Drop the existing alias
-	err = client.DropAlias(&zilliz.DropAliasParams{
-		DbName:    state.DbName.ValueString(),
-		AliasName: state.AliasName.ValueString(),
-	})
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to drop existing alias during update",
-			fmt.Sprintf("ConnectAddress: %s, DbName: %s, AliasName: %s, error: %s", connectAddress, state.DbName.ValueString(), state.AliasName.ValueString(), err.Error()),
-		)
-		return
-	}
-
-	// Step 2: Create the new alias with updated values
-	newConnectAddress := plan.ConnectAddress.ValueString()
-	newClient, err := r.client.Collection(newConnectAddress, plan.DbName.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to get collection client for new alias",
-			fmt.Sprintf("ConnectAddress: %s, error: %s", newConnectAddress, err.Error()),
-		)
-		return
-	}
-
-	err = newClient.CreateAlias(&zilliz.CreateAliasParams{
+	// The alias is swapped to the new collection in one call, so it never stops resolving.
+	err = client.AlterAliases(&zilliz.AlterAliasesParams{
 		DbName:         plan.DbName.ValueString(),
 		AliasName:      plan.AliasName.ValueString(),
 		CollectionName: plan.CollectionName.ValueString(),
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Failed to create new alias during update",
-			fmt.Sprintf("ConnectAddress: %s, DbName: %s, AliasName: %s, error: %s", newConnectAddress, plan.DbName.ValueString(), plan.AliasName.ValueString(), err.Error()),
+			"Failed to alter alias",
+			fmt.Sprintf("ConnectAddress: %s, DbName: %s, AliasName: %s, CollectionName: %s, error: %s", connectAddress, plan.DbName.ValueString(), plan.AliasName.ValueString(), plan.CollectionName.ValueString(), err.Error()),
 		)
 		return
 	}
 
-	// Update the ID with normalized connection address
-	normalizedConnectAddress := NormalizeConnectionID(newConnectAddress)
-	plan.Id = types.StringValue(BuildAliasID(normalizedConnectAddress, plan.DbName.ValueString(), plan.AliasName.ValueString()))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...) // Save state
 }
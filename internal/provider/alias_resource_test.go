@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/zilliztech/terraform-provider-zillizcloud/internal/provider"
 )
@@ -101,10 +102,9 @@ resource "zillizcloud_alias" "test" {
 			},
 			// Step 3: Import alias
 			{
-				ResourceName:            "zillizcloud_alias.test",
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"collection_name"},
+				ResourceName:      "zillizcloud_alias.test",
+				ImportState:       true,
+				ImportStateVerify: true,
 				ImportStateIdFunc: func(state *terraform.State) (string, error) {
 					rs, ok := state.RootModule().Resources["zillizcloud_alias.test"]
 					if !ok {
@@ -120,3 +120,100 @@ resource "zillizcloud_alias" "test" {
 		},
 	})
 }
+
+func testAccAliasRetargetConfig(target string) string {
+	return provider.ProviderConfig + fmt.Sprintf(`
+resource "zillizcloud_database" "test" {
+  connect_address = "https://in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534"
+  db_name         = "testdb"
+}
+resource "zillizcloud_collection" "blue" {
+  connect_address = zillizcloud_database.test.connect_address
+  db_name         = zillizcloud_database.test.db_name
+  collection_name = "testcollection_blue"
+  schema = {
+    fields = [
+      {
+        field_name = "id"
+        data_type  = "Int64"
+        is_primary = true
+      },
+      {
+        field_name = "vector"
+        data_type  = "FloatVector"
+        element_type_params = {
+          dim = "128"
+        }
+      }
+    ]
+  }
+}
+resource "zillizcloud_collection" "green" {
+  connect_address = zillizcloud_database.test.connect_address
+  db_name         = zillizcloud_database.test.db_name
+  collection_name = "testcollection_green"
+  schema = {
+    fields = [
+      {
+        field_name = "id"
+        data_type  = "Int64"
+        is_primary = true
+      },
+      {
+        field_name = "vector"
+        data_type  = "FloatVector"
+        element_type_params = {
+          dim = "128"
+        }
+      }
+    ]
+  }
+}
+resource "zillizcloud_alias" "test" {
+  connect_address = zillizcloud_database.test.connect_address
+  db_name         = zillizcloud_database.test.db_name
+  alias_name      = "testalias_live"
+  collection_name = zillizcloud_collection.%s.collection_name
+}
+`, target)
+}
+
+func TestAccAliasResourceRetarget(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Step 1: Point the alias at the blue collection
+			{
+				Config: testAccAliasRetargetConfig("blue"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zillizcloud_alias.test", "collection_name", "testcollection_blue"),
+				),
+			},
+			// Step 2: Cut over to green in place, keeping the alias and its ID
+			{
+				Config: testAccAliasRetargetConfig("green"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("zillizcloud_alias.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zillizcloud_alias.test", "collection_name", "testcollection_green"),
+					resource.TestCheckResourceAttrPair("zillizcloud_alias.test", "collection_name", "zillizcloud_collection.green", "collection_name"),
+				),
+			},
+			// Step 3: Roll back to blue
+			{
+				Config: testAccAliasRetargetConfig("blue"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("zillizcloud_alias.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zillizcloud_alias.test", "collection_name", "testcollection_blue"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+)
+
+var _ ephemeral.EphemeralResource = &ApiKeyEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ApiKeyEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &ApiKeyEphemeralResource{}
+
+const apiKeyEphemeralPrivateKey = "api_key"
+
+func NewApiKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &ApiKeyEphemeralResource{}
+}
+
+type ApiKeyEphemeralResource struct {
+	client *zilliz.Client
+}
+
+type ApiKeyEphemeralResourceModel struct {
+	Id            types.String               `tfsdk:"id"`
+	Name          types.String               `tfsdk:"name"`
+	Role          types.String               `tfsdk:"role"`
+	ProjectAccess []ApiKeyProjectAccessModel `tfsdk:"project_access"`
+	KeyValue      types.String               `tfsdk:"key_value"`
+}
+
+// apiKeyEphemeralPrivate is kept in private data between Open and Close.
+type apiKeyEphemeralPrivate struct {
+	ApiKeyId string `json:"apiKeyId"`
+}
+
+func (r *ApiKeyEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (r *ApiKeyEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Issues a Zilliz Cloud API key that is never stored in the Terraform plan or state.
+
+The key is created every time Terraform opens the ephemeral resource (during both plan and apply) and deleted again
+when Terraform closes it at the end of the run. Use it to configure other providers for the duration of the run; use the
+` + "`zillizcloud_api_key`" + ` resource for keys that have to outlive the run.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the API key.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the API key.",
+			},
+			"role": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: `The organization role for this API key. Valid values: "Member", "Billing-Admin".`,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Member", "Billing-Admin"),
+				},
+			},
+			"project_access": schema.ListNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Project access configuration. Required when role is Member.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"project_id": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The project ID to grant access to.",
+						},
+						"role": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: `The project role. Valid values: "Admin", "Read-Write", "Read-Only".`,
+							Validators: []validator.String{
+								stringvalidator.OneOf("Admin", "Read-Write", "Read-Only"),
+							},
+						},
+						"all_cluster": schema.BoolAttribute{
+							Optional:            true,
+							MarkdownDescription: "Whether to include all clusters in this project. Defaults to true unless `cluster_ids` is set.",
+						},
+						"cluster_ids": schema.ListAttribute{
+							Optional:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Specific cluster IDs when all_cluster is false.",
+						},
+					},
+				},
+			},
+			"key_value": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The API key value.",
+			},
+		},
+	}
+}
+
+func (r *ApiKeyEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*zilliz.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected provider type",
+			fmt.Sprintf("Expected *zilliz.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *ApiKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ApiKeyEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Role.ValueString() == "Member" && len(data.ProjectAccess) == 0 {
+		resp.Diagnostics.AddError(
+			"Missing project_access",
+			`At least one "project_access" entry is required when role is "Member".`,
+		)
+		return
+	}
+
+	// There are no defaults in ephemeral schemas, so all_cluster is resolved here.
+	for i := range data.ProjectAccess {
+		if data.ProjectAccess[i].AllCluster.IsNull() {
+			data.ProjectAccess[i].AllCluster = types.BoolValue(data.ProjectAccess[i].ClusterIds.IsNull())
+		}
+	}
+	projects, diags := buildApiKeyProjectAccess(ctx, data.ProjectAccess)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateApiKey(&zilliz.CreateApiKeyRequest{
+		Name:     data.Name.ValueString(),
+		OrgRole:  data.Role.ValueString(),
+		Projects: projects,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create API key", err.Error())
+		return
+	}
+
+	private, err := json.Marshal(apiKeyEphemeralPrivate{ApiKeyId: created.ApiKeyId})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to save API key private data", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, apiKeyEphemeralPrivateKey, private)...)
+
+	data.Id = types.StringValue(created.ApiKeyId)
+	data.KeyValue = types.StringValue(created.ApiKey)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *ApiKeyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	raw, diags := req.Private.GetKey(ctx, apiKeyEphemeralPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || raw == nil {
+		return
+	}
+
+	var private apiKeyEphemeralPrivate
+	if err := json.Unmarshal(raw, &private); err != nil {
+		resp.Diagnostics.AddError("Failed to read API key private data", err.Error())
+		return
+	}
+	err := r.client.DeleteApiKey(private.ApiKeyId)
+	if err != nil {
+		var apiErr *zilliz.Error
+		if errors.As(err, &apiErr) && apiErr.Code == 404 {
+			return
+		}
+		resp.Diagnostics.AddError("Failed to delete API key", fmt.Sprintf("API key ID: %s, error: %s", private.ApiKeyId, err.Error()))
+	}
+}
@@ -0,0 +1,48 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/zilliztech/terraform-provider-zillizcloud/internal/provider"
+)
+
+func TestAccApiKeyEphemeralResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: testAccEchoProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: provider.ProviderConfig + `
+data "zillizcloud_project" "default" {
+}
+
+ephemeral "zillizcloud_api_key" "test" {
+  name = "tf-acc-ephemeral-key"
+  role = "Member"
+
+  project_access = [{
+    project_id = data.zillizcloud_project.default.id
+    role       = "Read-Only"
+  }]
+}
+
+provider "echo" {
+  data = {
+    id = ephemeral.zillizcloud_api_key.test.id
+  }
+}
+
+resource "echo" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("echo.test", "data.id", regexp.MustCompile(`.+`)),
+				),
+			},
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -230,24 +231,9 @@ func (r *ApiKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 		OrgRole: data.Role.ValueString(),
 	}
 
-	for _, pa := range data.ProjectAccess {
-		p := zilliz.ApiKeyProjectAccess{
-			ProjectId: pa.ProjectId.ValueString(),
-			Role:      pa.Role.ValueString(),
-		}
-		allCluster := pa.AllCluster.ValueBool()
-		p.AllCluster = &allCluster
-		// Default allVolume to true since the API requires it but we don't expose it in schema
-		allVolume := true
-		p.AllVolume = &allVolume
-
-		if !pa.ClusterIds.IsNull() {
-			var ids []string
-			resp.Diagnostics.Append(pa.ClusterIds.ElementsAs(ctx, &ids, false)...)
-			p.ClusterIds = ids
-		}
-		createReq.Projects = append(createReq.Projects, p)
-	}
+	projects, diags := buildApiKeyProjectAccess(ctx, data.ProjectAccess)
+	resp.Diagnostics.Append(diags...)
+	createReq.Projects = projects
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -321,24 +307,9 @@ func (r *ApiKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		OrgRole: plan.Role.ValueString(),
 	}
 
-	for _, pa := range plan.ProjectAccess {
-		p := zilliz.ApiKeyProjectAccess{
-			ProjectId: pa.ProjectId.ValueString(),
-			Role:      pa.Role.ValueString(),
-		}
-		allCluster := pa.AllCluster.ValueBool()
-		p.AllCluster = &allCluster
-		// Default allVolume to true since the API requires it but we don't expose it in schema
-		allVolume := true
-		p.AllVolume = &allVolume
-
-		if !pa.ClusterIds.IsNull() {
-			var ids []string
-			resp.Diagnostics.Append(pa.ClusterIds.ElementsAs(ctx, &ids, false)...)
-			p.ClusterIds = ids
-		}
-		updateReq.Projects = append(updateReq.Projects, p)
-	}
+	projects, diags := buildApiKeyProjectAccess(ctx, plan.ProjectAccess)
+	resp.Diagnostics.Append(diags...)
+	updateReq.Projects = projects
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -399,6 +370,30 @@ func (r *ApiKeyResource) ImportState(ctx context.Context, req resource.ImportSta
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func buildApiKeyProjectAccess(ctx context.Context, access []ApiKeyProjectAccessModel) ([]zilliz.ApiKeyProjectAccess, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var projects []zilliz.ApiKeyProjectAccess
+	for _, pa := range access {
+		p := zilliz.ApiKeyProjectAccess{
+			ProjectId: pa.ProjectId.ValueString(),
+			Role:      pa.Role.ValueString(),
+		}
+		allCluster := pa.AllCluster.ValueBool()
+		p.AllCluster = &allCluster
+		// Default allVolume to true since the API requires it but we don't expose it in schema
+		allVolume := true
+		p.AllVolume = &allVolume
+
+		if !pa.ClusterIds.IsNull() {
+			var ids []string
+			diags.Append(pa.ClusterIds.ElementsAs(ctx, &ids, false)...)
+			p.ClusterIds = ids
+		}
+		projects = append(projects, p)
+	}
+	return projects, diags
+}
+
 func populateProjectAccess(data *ApiKeyResourceModel, projects []zilliz.ApiKeyProjectResponse) {
 	if len(projects) == 0 {
 		data.ProjectAccess = nil
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+	"github.com/zilliztech/terraform-provider-zillizcloud/internal/util/version"
+)
+
+var _ datasource.DataSource = &AvailableVersionsDataSource{}
+
+func NewAvailableVersionsDataSource() datasource.DataSource {
+	return &AvailableVersionsDataSource{}
+}
+
+// AvailableVersionsDataSource lists the Milvus versions of a region.
+type AvailableVersionsDataSource struct {
+	client *zilliz.Client
+}
+
+type AvailableVersionsDataSourceModel struct {
+	RegionId       types.String   `tfsdk:"region_id"`
+	Versions       []types.String `tfsdk:"versions"`
+	DefaultVersion types.String   `tfsdk:"default_version"`
+}
+
+func (d *AvailableVersionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_available_versions"
+}
+
+func (d *AvailableVersionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Lists the Milvus versions clusters in a region can run.
+
+Typical use case: Read the versions once and pass one of them as ` + "`target_version`" + ` to the ` + "`zillizcloud_cluster`" + ` resources of each environment, so a version is promoted from staging to production through the same configuration.`,
+		Attributes: map[string]schema.Attribute{
+			"region_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the region, such as aws-us-west-2. Defaults to the region of the provider.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"versions": schema.ListAttribute{
+				MarkdownDescription: "The available Milvus versions, in ascending order.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"default_version": schema.StringAttribute{
+				MarkdownDescription: "The version new clusters run when `target_version` is not set.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *AvailableVersionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*zilliz.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zilliz.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AvailableVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state AvailableVersionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	regionId := state.RegionId.ValueString()
+	if regionId == "" {
+		regionId = d.client.RegionId
+	}
+
+	tflog.Trace(ctx, "sending list milvus versions request...")
+	versions, err := d.client.ListMilvusVersions(regionId)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to ListMilvusVersions, got error: %s", err))
+		return
+	}
+
+	names := make([]string, 0, len(versions))
+	for _, v := range versions {
+		names = append(names, v.Version)
+	}
+	version.Sort(names)
+
+	state.RegionId = types.StringValue(regionId)
+	state.Versions = make([]types.String, 0, len(names))
+	for _, name := range names {
+		state.Versions = append(state.Versions, types.StringValue(name))
+	}
+	state.DefaultVersion = types.StringValue(defaultMilvusVersion(versions))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// defaultMilvusVersion returns the version marked as default, or the latest
+// version when the API marks none.
+func defaultMilvusVersion(versions []zilliz.MilvusVersion) string {
+	latest := ""
+	for _, v := range versions {
+		if v.IsDefault {
+			return v.Version
+		}
+		if cmp, ok := version.Compare(v.Version, latest); latest == "" || (ok && cmp > 0) {
+			latest = v.Version
+		}
+	}
+	return latest
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"testing"
+
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+)
+
+func TestDefaultMilvusVersion(t *testing.T) {
+	testCases := []struct {
+		name string
+		in   []zilliz.MilvusVersion
+		want string
+	}{
+		{
+			name: "uses the default version",
+			in:   []zilliz.MilvusVersion{{Version: "2.4.17", IsDefault: true}, {Version: "2.5.4"}},
+			want: "2.4.17",
+		},
+		{
+			name: "falls back to the latest version",
+			in:   []zilliz.MilvusVersion{{Version: "2.4.17"}, {Version: "2.5.4"}},
+			want: "2.5.4",
+		},
+		{
+			name: "falls back to the numerically latest version",
+			in:   []zilliz.MilvusVersion{{Version: "2.5.10"}, {Version: "2.5.9"}},
+			want: "2.5.10",
+		},
+		{
+			name: "no versions",
+			want: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := defaultMilvusVersion(tc.in)
+			if got != tc.want {
+				t.Errorf("want=%s, got=%s", tc.want, got)
+			}
+		})
+	}
+}
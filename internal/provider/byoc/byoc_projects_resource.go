@@ -15,9 +15,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
@@ -172,6 +174,12 @@ func (r *BYOCProjectResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 			},
 			"instances": zschema.Instances,
+			"wait_for_ready": schema.BoolAttribute{
+				MarkdownDescription: "Whether creation waits for the project to reach RUNNING, within the create timeout. By default creation returns as soon as the project and data plane IDs are known, and `status` keeps the configured value until provisioning finishes. If the wait fails, the project is kept and a warning is reported. Defaults to false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx,
@@ -233,6 +241,14 @@ func (r *BYOCProjectResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	if data.waitsForReady() {
+		// The project is already in state. An error would taint it and the
+		// next apply would recreate it, so only warn.
+		if err := r.store.WaitForRunning(ctx, &data); err != nil {
+			resp.Diagnostics.AddWarning("BYOC project created but not running", err.Error())
+			return
+		}
+	}
 }
 
 func (r *BYOCProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -255,8 +271,15 @@ func (r *BYOCProjectResource) Read(ctx context.Context, req resource.ReadRequest
 
 	data.AWS = model.AWS
 	data.Instances = model.Instances
-	data.Status = model.Status
 	data.DataPlaneID = model.DataPlaneID
+	// A project created without waiting keeps its configured status until
+	// provisioning finishes, so the pending states do not show up as drift.
+	if data.waitsForReady() || !isProvisioningStatus(model.Status.ValueString()) {
+		data.Status = model.Status
+	}
+	if data.WaitForReady.IsNull() {
+		data.WaitForReady = types.BoolValue(false)
+	}
 
 	tflog.Info(ctx, fmt.Sprintf("Read BYOC Project response: %+v", data))
 
@@ -297,6 +320,13 @@ func (r *BYOCProjectResource) Update(ctx context.Context, req resource.UpdateReq
 	tflog.Info(ctx, fmt.Sprintf("Update BYOC Project current state: %+v", currentData))
 	tflog.Info(ctx, fmt.Sprintf("Update BYOC Project planned state: %+v", plannedData))
 
+	// wait_for_ready only applies to creation, changing it alone must not
+	// send the project through the create call below.
+	if onlyWaitForReadyChanged(ctx, req) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plannedData)...)
+		return
+	}
+
 	currentStatus := currentData.Status.ValueString()
 	plannedStatus := plannedData.Status.ValueString()
 
@@ -350,6 +380,18 @@ func (r *BYOCProjectResource) Update(ctx context.Context, req resource.UpdateReq
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plannedData)...)
 }
 
+func onlyWaitForReadyChanged(ctx context.Context, req resource.UpdateRequest) bool {
+	var waitForReady types.Bool
+	if diags := req.Plan.GetAttribute(ctx, path.Root("wait_for_ready"), &waitForReady); diags.HasError() {
+		return false
+	}
+	state := tfsdk.State{Schema: req.State.Schema, Raw: req.State.Raw.Copy()}
+	if diags := state.SetAttribute(ctx, path.Root("wait_for_ready"), waitForReady); diags.HasError() {
+		return false
+	}
+	return state.Raw.Equal(req.Plan.Raw)
+}
+
 func (r *BYOCProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	tflog.Info(ctx, "Delete BYOC Project...")
 	var data BYOCProjectResourceModel
@@ -65,6 +65,7 @@ resource "zillizcloud_byoc_project" "test" {
 					resource.TestCheckResourceAttrSet("zillizcloud_byoc_project.test", "id"),
 					resource.TestCheckResourceAttrSet("zillizcloud_byoc_project.test", "data_plane_id"),
 					resource.TestCheckResourceAttrSet("zillizcloud_byoc_project.test", "status"),
+					resource.TestCheckResourceAttr("zillizcloud_byoc_project.test", "wait_for_ready", "false"),
 				),
 				PreventPostDestroyRefresh: true,
 			},
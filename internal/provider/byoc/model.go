@@ -14,6 +14,14 @@ type BYOCProjectResourceModel struct {
 	Instances   InstancesConfig `tfsdk:"instances"`
 	Timeouts    timeouts.Value  `tfsdk:"timeouts"`
 	Status      types.String    `tfsdk:"status"`
+
+	WaitForReady types.Bool `tfsdk:"wait_for_ready"`
+}
+
+// waitsForReady reports whether creation waits for the project to reach
+// RUNNING, which only happens when wait_for_ready is set to true.
+func (data *BYOCProjectResourceModel) waitsForReady() bool {
+	return data.WaitForReady.ValueBool()
 }
 
 type AWSConfig struct {
@@ -108,3 +116,14 @@ func (s BYOCProjectStatus) String() string {
 		return "UNKNOWN"
 	}
 }
+
+// isProvisioningStatus reports whether a newly created project is still on
+// its way to RUNNING.
+func isProvisioningStatus(status string) bool {
+	switch status {
+	case BYOCProjectStatusPending.String(), BYOCProjectStatusInit.String(), BYOCProjectStatusConnected.String():
+		return true
+	default:
+		return false
+	}
+}
@@ -17,6 +17,7 @@ type ByocProjectStore interface {
 	Describe(ctx context.Context, projectID string, dataPlaneID string) (model BYOCProjectResourceModel, err error)
 	Suspend(ctx context.Context, data *BYOCProjectResourceModel) (err error)
 	Resume(ctx context.Context, data *BYOCProjectResourceModel) (err error)
+	WaitForRunning(ctx context.Context, data *BYOCProjectResourceModel) (err error)
 }
 
 type byocProjectStore struct {
@@ -98,6 +99,36 @@ func (s *byocProjectStore) Resume(ctx context.Context, data *BYOCProjectResource
 	return
 }
 
+// WaitForRunning waits for a newly created project to finish provisioning.
+func (s *byocProjectStore) WaitForRunning(ctx context.Context, data *BYOCProjectResourceModel) (err error) {
+	timeout, diags := data.Timeouts.Create(ctx, defaultBYOCProjectCreateTimeout)
+	if diags.HasError() {
+		return fmt.Errorf("failed to get create timeout")
+	}
+	_, err = util.Poll[any](ctx, timeout, func() (*any, *util.Err) {
+		project, err := s.Describe(ctx, data.ID.ValueString(), data.DataPlaneID.ValueString())
+		if err != nil {
+			return nil, &util.Err{Halt: true, Err: fmt.Errorf("failed to check BYOC project status")}
+		}
+
+		status := project.Status.ValueString()
+		switch {
+		case isProvisioningStatus(status):
+			return nil, &util.Err{Err: fmt.Errorf("BYOC project is %s", status)}
+		case status == BYOCProjectStatusRunning.String():
+			// achieved the target status
+			return nil, nil
+		default:
+			return nil, &util.Err{Halt: true, Err: fmt.Errorf("BYOC project is in unexpected state: %s", status)}
+		}
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to wait for BYOC project to be running: %w", err)
+	}
+	return
+}
+
 func (s *byocProjectStore) Describe(ctx context.Context, projectID string, dataPlaneID string) (data BYOCProjectResourceModel, _ error) {
 	var err error
 
@@ -0,0 +1,269 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+)
+
+var _ ephemeral.EphemeralResource = &ClusterCredentialsEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ClusterCredentialsEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &ClusterCredentialsEphemeralResource{}
+
+const (
+	clusterCredentialsPrivateKey    = "cluster_credentials"
+	defaultClusterCredentialsPrefix = "tf_"
+)
+
+func NewClusterCredentialsEphemeralResource() ephemeral.EphemeralResource {
+	return &ClusterCredentialsEphemeralResource{}
+}
+
+type ClusterCredentialsEphemeralResource struct {
+	client *zilliz.Client
+}
+
+type ClusterCredentialsEphemeralResourceModel struct {
+	ConnectAddress types.String `tfsdk:"connect_address"`
+	UsernamePrefix types.String `tfsdk:"username_prefix"`
+	Roles          types.Set    `tfsdk:"roles"`
+	Username       types.String `tfsdk:"username"`
+	Password       types.String `tfsdk:"password"`
+}
+
+// clusterCredentialsPrivate is kept in private data between Open and Close.
+type clusterCredentialsPrivate struct {
+	ConnectAddress string `json:"connectAddress"`
+	Username       string `json:"username"`
+}
+
+func (r *ClusterCredentialsEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_credentials"
+}
+
+func (r *ClusterCredentialsEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Issues short-lived database credentials for a Zilliz Cloud cluster. The credentials are never stored in the Terraform plan or state.
+
+When Terraform opens the ephemeral resource, a user with a random name and password is created in the cluster and
+granted the given roles. The user is dropped again when Terraform closes the ephemeral resource at the end of the run,
+so the credentials only suit consumers that use them during the run, such as provider blocks or provisioners. Use the
+` + "`zillizcloud_user`" + ` resource for credentials that have to outlive the run.`,
+		Attributes: map[string]schema.Attribute{
+			"connect_address": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: `The connection address of the target Zilliz Cloud cluster.
+You can obtain this value from the output of the ` + "`zillizcloud_cluster`" + ` resource, for example:
+` + "`zillizcloud_cluster.example.connect_address`" + `
+
+**Example:**
+` + "`https://in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534`" + `
+
+> **Note:** The address must include the protocol (e.g., ` + "`https://`" + `).`,
+			},
+			"username_prefix": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The prefix of the generated username. A random suffix is appended to it. Defaults to `tf_`.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 16),
+				},
+			},
+			"roles": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The roles to grant to the generated user, for example `db_ro` or `db_rw`.",
+			},
+			"username": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The generated username.",
+			},
+			"password": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The generated password.",
+			},
+		},
+	}
+}
+
+func (r *ClusterCredentialsEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*zilliz.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected provider type",
+			fmt.Sprintf("Expected *zilliz.Client, got: %T. Please check provider configuration.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *ClusterCredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ClusterCredentialsEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var roles []string
+	if !data.Roles.IsNull() {
+		resp.Diagnostics.Append(data.Roles.ElementsAs(ctx, &roles, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	prefix := defaultClusterCredentialsPrefix
+	if !data.UsernamePrefix.IsNull() {
+		prefix = data.UsernamePrefix.ValueString()
+	}
+	username, password, err := generateClusterCredentials(prefix)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to generate credentials", err.Error())
+		return
+	}
+
+	connectAddress := data.ConnectAddress.ValueString()
+	client, err := r.client.User(connectAddress)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get user client",
+			fmt.Sprintf("ConnectAddress: %s, error: %s", connectAddress, err.Error()),
+		)
+		return
+	}
+
+	err = client.CreateUser(&zilliz.CreateUserParams{
+		Username: username,
+		Password: password,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create user",
+			fmt.Sprintf("ConnectAddress: %s, Username: %s, error: %s", connectAddress, username, err.Error()),
+		)
+		return
+	}
+
+	// Record the user before granting roles, so Close drops it even if a grant fails.
+	private, err := json.Marshal(clusterCredentialsPrivate{ConnectAddress: connectAddress, Username: username})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to save credentials private data", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, clusterCredentialsPrivateKey, private)...)
+
+	for _, role := range roles {
+		err = client.GrantRoleToUser(&zilliz.UserGrantRoleToUserParams{
+			UserName: username,
+			RoleName: role,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to grant role to user",
+				fmt.Sprintf("ConnectAddress: %s, Username: %s, Role: %s, error: %s", connectAddress, username, role, err.Error()),
+			)
+			return
+		}
+	}
+
+	data.Username = types.StringValue(username)
+	data.Password = types.StringValue(password)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *ClusterCredentialsEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	raw, diags := req.Private.GetKey(ctx, clusterCredentialsPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || raw == nil {
+		return
+	}
+
+	var private clusterCredentialsPrivate
+	if err := json.Unmarshal(raw, &private); err != nil {
+		resp.Diagnostics.AddError("Failed to read credentials private data", err.Error())
+		return
+	}
+
+	client, err := r.client.User(private.ConnectAddress)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get user client",
+			fmt.Sprintf("ConnectAddress: %s, error: %s", private.ConnectAddress, err.Error()),
+		)
+		return
+	}
+
+	err = client.DropUser(&zilliz.DropUserParams{
+		Username: private.Username,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to delete user",
+			fmt.Sprintf("ConnectAddress: %s, Username: %s, error: %s", private.ConnectAddress, private.Username, err.Error()),
+		)
+	}
+}
+
+const (
+	passwordLower   = "abcdefghijklmnopqrstuvwxyz"
+	passwordUpper   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	passwordDigits  = "0123456789"
+	passwordSpecial = "!#$%&*()-_=+[]{}<>:?"
+)
+
+// generateClusterCredentials returns a random username with the given prefix
+// and a 24 character password that contains every character class the
+// cluster password policy asks for.
+func generateClusterCredentials(prefix string) (string, string, error) {
+	suffix := make([]byte, 6)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", "", err
+	}
+	username := prefix + hex.EncodeToString(suffix)
+
+	classes := []string{passwordLower, passwordUpper, passwordDigits, passwordSpecial}
+	all := passwordLower + passwordUpper + passwordDigits + passwordSpecial
+	password := make([]byte, 0, 24)
+	for i := 0; i < cap(password); i++ {
+		charset := all
+		if i < len(classes) {
+			charset = classes[i]
+		}
+		c, err := randomChar(charset)
+		if err != nil {
+			return "", "", err
+		}
+		password = append(password, c)
+	}
+	// Shuffle so the guaranteed characters are not always at the front.
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", "", err
+		}
+		password[i], password[j.Int64()] = password[j.Int64()], password[i]
+	}
+	return username, string(password), nil
+}
+
+func randomChar(charset string) (byte, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(len(charset))))
+	if err != nil {
+		return 0, err
+	}
+	return charset[n.Int64()], nil
+}
@@ -0,0 +1,55 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/zilliztech/terraform-provider-zillizcloud/internal/provider"
+)
+
+// testAccEchoProtoV6ProviderFactories adds the echo provider, which copies an
+// ephemeral value into state so acceptance tests can check it.
+func testAccEchoProtoV6ProviderFactories() map[string]func() (tfprotov6.ProviderServer, error) {
+	factories := map[string]func() (tfprotov6.ProviderServer, error){
+		"echo": echoprovider.NewProviderServer(),
+	}
+	for name, factory := range provider.TestAccProtoV6ProviderFactories {
+		factories[name] = factory
+	}
+	return factories
+}
+
+func TestAccClusterCredentialsEphemeralResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: testAccEchoProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: provider.ProviderConfig + `
+ephemeral "zillizcloud_cluster_credentials" "test" {
+  connect_address = "https://in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534"
+  username_prefix = "tf_acc_"
+  roles           = ["db_ro"]
+}
+
+provider "echo" {
+  data = {
+    username = ephemeral.zillizcloud_cluster_credentials.test.username
+  }
+}
+
+resource "echo" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("echo.test", "data.username", regexp.MustCompile(`^tf_acc_[0-9a-f]{12}$`)),
+				),
+			},
+		},
+	})
+}
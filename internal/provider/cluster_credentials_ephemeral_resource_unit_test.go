@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerateClusterCredentials(t *testing.T) {
+	seen := map[string]bool{}
+	for i := 0; i < 50; i++ {
+		username, password, err := generateClusterCredentials("tf_")
+		if err != nil {
+			t.Fatalf("generateClusterCredentials: %v", err)
+		}
+		if !strings.HasPrefix(username, "tf_") || len(username) != len("tf_")+12 {
+			t.Errorf("username = %q", username)
+		}
+		if seen[username] {
+			t.Errorf("duplicate username %q", username)
+		}
+		seen[username] = true
+
+		if len(password) != 24 {
+			t.Errorf("password length = %d, want 24", len(password))
+		}
+		for _, charset := range []string{passwordLower, passwordUpper, passwordDigits, passwordSpecial} {
+			if !strings.ContainsAny(password, charset) {
+				t.Errorf("password %q has no character from %q", password, charset)
+			}
+		}
+	}
+}
@@ -0,0 +1,321 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+)
+
+var _ datasource.DataSource = &CollectionDataSource{}
+
+func NewCollectionDataSource() datasource.DataSource {
+	return &CollectionDataSource{}
+}
+
+type CollectionDataSource struct {
+	client *zilliz.Client
+}
+
+type CollectionFieldItem struct {
+	FieldName         types.String            `tfsdk:"field_name"`
+	DataType          types.String            `tfsdk:"data_type"`
+	ElementDataType   types.String            `tfsdk:"element_data_type"`
+	Description       types.String            `tfsdk:"description"`
+	IsPrimary         types.Bool              `tfsdk:"is_primary"`
+	IsPartitionKey    types.Bool              `tfsdk:"is_partition_key"`
+	IsClusteringKey   types.Bool              `tfsdk:"is_clustering_key"`
+	AutoID            types.Bool              `tfsdk:"auto_id"`
+	Nullable          types.Bool              `tfsdk:"nullable"`
+	Dim               types.Int64             `tfsdk:"dim"`
+	ElementTypeParams map[string]types.String `tfsdk:"element_type_params"`
+}
+
+type CollectionIndexItem struct {
+	FieldName  types.String `tfsdk:"field_name"`
+	IndexName  types.String `tfsdk:"index_name"`
+	MetricType types.String `tfsdk:"metric_type"`
+}
+
+type CollectionDataSourceModel struct {
+	ConnectAddress     types.String            `tfsdk:"connect_address"`
+	DbName             types.String            `tfsdk:"db_name"`
+	CollectionName     types.String            `tfsdk:"collection_name"`
+	CollectionID       types.Int64             `tfsdk:"collection_id"`
+	Description        types.String            `tfsdk:"description"`
+	AutoID             types.Bool              `tfsdk:"auto_id"`
+	EnableDynamicField types.Bool              `tfsdk:"enable_dynamic_field"`
+	ConsistencyLevel   types.String            `tfsdk:"consistency_level"`
+	LoadState          types.String            `tfsdk:"load_state"`
+	ShardsNum          types.Int64             `tfsdk:"shards_num"`
+	PartitionsNum      types.Int64             `tfsdk:"partitions_num"`
+	RowCount           types.Int64             `tfsdk:"row_count"`
+	Aliases            []types.String          `tfsdk:"aliases"`
+	Properties         map[string]types.String `tfsdk:"properties"`
+	Fields             []CollectionFieldItem   `tfsdk:"fields"`
+	Indexes            []CollectionIndexItem   `tfsdk:"indexes"`
+}
+
+func (d *CollectionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collection"
+}
+
+func (d *CollectionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Read an existing collection by connect_address, db_name and collection_name, including its schema, indexes, aliases, load state and row count",
+		Attributes: map[string]schema.Attribute{
+			"connect_address": schema.StringAttribute{
+				MarkdownDescription: `The connection address of the target Zilliz Cloud cluster.
+You can obtain this value from the output of the ` + "`zillizcloud_cluster`" + ` resource, for example:
+` + "`zillizcloud_cluster.example.connect_address`" + `
+
+**Example:**
+` + "`https://in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534`" + `
+
+> **Note:** The address must include the protocol (e.g., ` + "`https://`" + `).`,
+				Required: true,
+			},
+			"db_name": schema.StringAttribute{
+				MarkdownDescription: "Database name",
+				Required:            true,
+			},
+			"collection_name": schema.StringAttribute{
+				MarkdownDescription: "Collection name",
+				Required:            true,
+			},
+			"collection_id": schema.Int64Attribute{
+				MarkdownDescription: "Collection ID",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Collection description",
+				Computed:            true,
+			},
+			"auto_id": schema.BoolAttribute{
+				MarkdownDescription: "Whether the primary key is generated automatically",
+				Computed:            true,
+			},
+			"enable_dynamic_field": schema.BoolAttribute{
+				MarkdownDescription: "Whether the dynamic field is enabled",
+				Computed:            true,
+			},
+			"consistency_level": schema.StringAttribute{
+				MarkdownDescription: "Consistency level, such as `Bounded` or `Strong`",
+				Computed:            true,
+			},
+			"load_state": schema.StringAttribute{
+				MarkdownDescription: "Load state, one of `LoadStateLoaded`, `LoadStateLoading` or `LoadStateNotLoad`",
+				Computed:            true,
+			},
+			"shards_num": schema.Int64Attribute{
+				MarkdownDescription: "Number of shards",
+				Computed:            true,
+			},
+			"partitions_num": schema.Int64Attribute{
+				MarkdownDescription: "Number of partitions",
+				Computed:            true,
+			},
+			"row_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of entities in the collection",
+				Computed:            true,
+			},
+			"aliases": schema.ListAttribute{
+				MarkdownDescription: "Aliases of the collection",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"properties": schema.MapAttribute{
+				MarkdownDescription: "Collection properties, such as `mmap.enabled` or `collection.ttl.seconds`",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"fields": schema.ListNestedAttribute{
+				MarkdownDescription: "Fields of the collection schema",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"field_name": schema.StringAttribute{
+							MarkdownDescription: "Field name",
+							Computed:            true,
+						},
+						"data_type": schema.StringAttribute{
+							MarkdownDescription: "Data type, such as `Int64`, `VarChar` or `FloatVector`",
+							Computed:            true,
+						},
+						"element_data_type": schema.StringAttribute{
+							MarkdownDescription: "Element data type of an `Array` field",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Field description",
+							Computed:            true,
+						},
+						"is_primary": schema.BoolAttribute{
+							MarkdownDescription: "Whether the field is the primary key",
+							Computed:            true,
+						},
+						"is_partition_key": schema.BoolAttribute{
+							MarkdownDescription: "Whether the field is the partition key",
+							Computed:            true,
+						},
+						"is_clustering_key": schema.BoolAttribute{
+							MarkdownDescription: "Whether the field is the clustering key",
+							Computed:            true,
+						},
+						"auto_id": schema.BoolAttribute{
+							MarkdownDescription: "Whether values of the field are generated automatically",
+							Computed:            true,
+						},
+						"nullable": schema.BoolAttribute{
+							MarkdownDescription: "Whether the field accepts null values",
+							Computed:            true,
+						},
+						"dim": schema.Int64Attribute{
+							MarkdownDescription: "Dimension of a vector field. Null for other fields.",
+							Computed:            true,
+						},
+						"element_type_params": schema.MapAttribute{
+							MarkdownDescription: "Type parameters of the field, such as `dim` or `max_length`",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"indexes": schema.ListNestedAttribute{
+				MarkdownDescription: "Indexes of the collection",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"field_name": schema.StringAttribute{
+							MarkdownDescription: "Indexed field name",
+							Computed:            true,
+						},
+						"index_name": schema.StringAttribute{
+							MarkdownDescription: "Index name",
+							Computed:            true,
+						},
+						"metric_type": schema.StringAttribute{
+							MarkdownDescription: "Metric type, such as `COSINE`, `L2` or `IP`",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CollectionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*zilliz.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zilliz.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *CollectionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state CollectionDataSourceModel
+
+	// Parse config input
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectAddress, dbName, collectionName := state.ConnectAddress.ValueString(), state.DbName.ValueString(), state.CollectionName.ValueString()
+	clientCollection, err := d.client.Collection(connectAddress, dbName)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to create collection client for connect_address %q, db_name %q: %s", connectAddress, dbName, err))
+		return
+	}
+
+	desc, err := clientCollection.DescribeCollection(&zilliz.DescribeCollectionParams{
+		CollectionName: collectionName,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to describe collection %q for connect_address %q, db_name %q: %s", collectionName, connectAddress, dbName, err))
+		return
+	}
+
+	stats, err := clientCollection.GetCollectionStats(&zilliz.GetCollectionStatsParams{
+		CollectionName: collectionName,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to get statistics of collection %q for connect_address %q, db_name %q: %s", collectionName, connectAddress, dbName, err))
+		return
+	}
+
+	state.populate(desc)
+	state.RowCount = types.Int64Value(stats.RowCount)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (m *CollectionDataSourceModel) populate(desc *zilliz.CollectionDescription) {
+	m.CollectionID = types.Int64Value(desc.CollectionID)
+	m.Description = types.StringValue(desc.Description)
+	m.AutoID = types.BoolValue(desc.AutoID)
+	m.EnableDynamicField = types.BoolValue(desc.EnableDynamicField)
+	m.ConsistencyLevel = types.StringValue(desc.ConsistencyLevel)
+	m.LoadState = types.StringValue(desc.Load)
+	m.ShardsNum = types.Int64Value(int64(desc.ShardsNum))
+	m.PartitionsNum = types.Int64Value(int64(desc.PartitionsNum))
+
+	m.Aliases = make([]types.String, 0, len(desc.Aliases))
+	for _, alias := range desc.Aliases {
+		m.Aliases = append(m.Aliases, types.StringValue(alias))
+	}
+
+	m.Properties = make(map[string]types.String, len(desc.Properties))
+	for _, p := range desc.Properties {
+		m.Properties[p.Key] = types.StringValue(p.Value)
+	}
+
+	m.Fields = make([]CollectionFieldItem, 0, len(desc.Fields))
+	for _, f := range desc.Fields {
+		item := CollectionFieldItem{
+			FieldName:         types.StringValue(f.Name),
+			DataType:          types.StringValue(canonicalCollectionDataType(f.Type)),
+			ElementDataType:   types.StringValue(canonicalCollectionDataType(f.ElementDataType)),
+			Description:       types.StringValue(f.Description),
+			IsPrimary:         types.BoolValue(f.PrimaryKey),
+			IsPartitionKey:    types.BoolValue(f.PartitionKey),
+			IsClusteringKey:   types.BoolValue(f.ClusteringKey),
+			AutoID:            types.BoolValue(f.AutoID),
+			Nullable:          types.BoolValue(f.Nullable),
+			Dim:               types.Int64Null(),
+			ElementTypeParams: make(map[string]types.String, len(f.Params)),
+		}
+		for _, p := range f.Params {
+			item.ElementTypeParams[p.Key] = types.StringValue(p.Value)
+			if p.Key == "dim" {
+				if dim, err := strconv.ParseInt(p.Value, 10, 64); err == nil {
+					item.Dim = types.Int64Value(dim)
+				}
+			}
+		}
+		m.Fields = append(m.Fields, item)
+	}
+
+	m.Indexes = make([]CollectionIndexItem, 0, len(desc.Indexes))
+	for _, idx := range desc.Indexes {
+		m.Indexes = append(m.Indexes, CollectionIndexItem{
+			FieldName:  types.StringValue(idx.FieldName),
+			IndexName:  types.StringValue(idx.IndexName),
+			MetricType: types.StringValue(idx.MetricType),
+		})
+	}
+}
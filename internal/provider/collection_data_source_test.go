@@ -0,0 +1,67 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/zilliztech/terraform-provider-zillizcloud/internal/provider"
+)
+
+func TestAccCollectionAndDatabaseDataSources(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: provider.ProviderConfig + `
+resource "zillizcloud_database" "test" {
+  connect_address = "https://in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534"
+  db_name         = "testdbds1"
+  max_collections = 10
+}
+resource "zillizcloud_collection" "test" {
+  connect_address = zillizcloud_database.test.connect_address
+  db_name         = zillizcloud_database.test.db_name
+  collection_name = "testcollectionds"
+  schema = {
+    auto_id = true
+    enabled_dynamic_field = false
+    fields = [
+      {
+        field_name = "id"
+        data_type  = "Int64"
+        is_primary = true
+      },
+      {
+        field_name = "vector"
+        data_type  = "FloatVector"
+        element_type_params = {
+          dim = "128"
+        }
+      }
+    ]
+  }
+}
+data "zillizcloud_collection" "test" {
+  connect_address = zillizcloud_collection.test.connect_address
+  db_name         = zillizcloud_collection.test.db_name
+  collection_name = zillizcloud_collection.test.collection_name
+}
+data "zillizcloud_database" "test" {
+  connect_address = zillizcloud_database.test.connect_address
+  db_name         = zillizcloud_database.test.db_name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.zillizcloud_collection.test", "auto_id", "true"),
+					resource.TestCheckResourceAttr("data.zillizcloud_collection.test", "fields.#", "2"),
+					resource.TestCheckResourceAttr("data.zillizcloud_collection.test", "fields.1.field_name", "vector"),
+					resource.TestCheckResourceAttr("data.zillizcloud_collection.test", "fields.1.dim", "128"),
+					resource.TestCheckResourceAttr("data.zillizcloud_collection.test", "row_count", "0"),
+					resource.TestCheckResourceAttrSet("data.zillizcloud_collection.test", "load_state"),
+					resource.TestCheckResourceAttr("data.zillizcloud_database.test", "max_collections", "10"),
+					resource.TestCheckResourceAttr("data.zillizcloud_database.test", "properties.database.max.collections", "10"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"testing"
+
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+)
+
+func TestCollectionDataSourcePopulate(t *testing.T) {
+	var m CollectionDataSourceModel
+	m.populate(&zilliz.CollectionDescription{
+		CollectionID:     42,
+		Aliases:          []string{"docs"},
+		ConsistencyLevel: "Bounded",
+		Load:             zilliz.CollectionLoadStateLoaded,
+		ShardsNum:        2,
+		Fields: []zilliz.CollectionField{
+			{Name: "id", Type: "Int64", PrimaryKey: true, AutoID: true},
+			{Name: "embedding", Type: "FloatVector", Params: []zilliz.FieldParam{{Key: "dim", Value: "768"}}},
+		},
+		Indexes:    []zilliz.CollectionIndex{{FieldName: "embedding", IndexName: "embedding_idx", MetricType: "COSINE"}},
+		Properties: []zilliz.CollectionProperty{{Key: "mmap.enabled", Value: "true"}},
+	})
+
+	if m.CollectionID.ValueInt64() != 42 || m.ShardsNum.ValueInt64() != 2 || len(m.Aliases) != 1 {
+		t.Errorf("collection = %+v", m)
+	}
+	if !m.Fields[0].Dim.IsNull() || !m.Fields[0].IsPrimary.ValueBool() {
+		t.Errorf("id field = %+v", m.Fields[0])
+	}
+	if m.Fields[1].Dim.ValueInt64() != 768 || m.Fields[1].ElementTypeParams["dim"].ValueString() != "768" {
+		t.Errorf("embedding field = %+v", m.Fields[1])
+	}
+	if len(m.Indexes) != 1 || m.Indexes[0].MetricType.ValueString() != "COSINE" {
+		t.Errorf("indexes = %+v", m.Indexes)
+	}
+	if m.Properties["mmap.enabled"].ValueString() != "true" {
+		t.Errorf("properties = %+v", m.Properties)
+	}
+}
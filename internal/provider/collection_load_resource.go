@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+)
+
+// NewCollectionLoadResource returns a new collection load resource.
+func NewCollectionLoadResource() resource.Resource {
+	return &CollectionLoadResource{}
+}
+
+type CollectionLoadResource struct {
+	client *zilliz.Client
+}
+
+var _ resource.ResourceWithImportState = &CollectionLoadResource{}
+
+type CollectionLoadResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	ConnectAddress types.String `tfsdk:"connect_address"`
+	DbName         types.String `tfsdk:"db_name"`
+	CollectionName types.String `tfsdk:"collection_name"`
+}
+
+func (r *CollectionLoadResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collection_load"
+}
+
+func (r *CollectionLoadResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Loads a collection into memory so it can be searched, and releases it again when the resource is destroyed.
+Milvus can only load a collection whose vector fields are indexed, so make this resource depend on the collection's ` + "`zillizcloud_index`" + ` resources.
+This lets a collection be created, indexed and loaded in a single apply.
+If the collection is released outside of Terraform, the next plan loads it again.
+
+> **Note:** Leave ` + "`load_state`" + ` unset on the ` + "`zillizcloud_collection`" + ` resource when its load is managed here.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: `The identifier of the loaded collection.
+
+**Format:**` + "`/connections/{connect_address}/databases/{db_name}/collections/{collection_name}`" + ``,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connect_address": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: `The connection address of the target Zilliz Cloud cluster.
+
+**Example:**
+` + "`https://in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534`" + `
+
+> **Note:** The address must include the protocol (e.g., ` + "`https://`" + `).`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"db_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: `Required. The name of the database containing the collection.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"collection_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: `Required. The name of the collection to load.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CollectionLoadResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*zilliz.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected provider type",
+			fmt.Sprintf("Expected *zilliz.Client, got: %T. Please check provider configuration.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *CollectionLoadResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CollectionLoadResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.Collection(data.ConnectAddress.ValueString(), data.DbName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get collection client",
+			fmt.Sprintf("ConnectAddress: %s, error: %s", data.ConnectAddress.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	err = ensureCollectionLoadState(ctx, client, data.DbName.ValueString(), data.CollectionName.ValueString(), CollectionLoaded)
+	if errors.Is(err, errCollectionNotIndexed) {
+		resp.Diagnostics.AddError(
+			"Collection not indexed",
+			fmt.Sprintf("CollectionName: %s: %s. Add the collection's zillizcloud_index resources to depends_on.", data.CollectionName.ValueString(), err.Error()),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to load collection",
+			fmt.Sprintf("ConnectAddress: %s, DbName: %s, CollectionName: %s, error: %s", data.ConnectAddress.ValueString(), data.DbName.ValueString(), data.CollectionName.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	data.Id = types.StringValue(BuildCollectionID(NormalizeConnectionID(data.ConnectAddress.ValueString()), data.DbName.ValueString(), data.CollectionName.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...) // Save state
+}
+
+func (r *CollectionLoadResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CollectionLoadResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...) // Get current state
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.Collection(data.ConnectAddress.ValueString(), data.DbName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get collection client",
+			fmt.Sprintf("ConnectAddress: %s, error: %s", data.ConnectAddress.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	state, err := getCollectionLoadState(client, data.DbName.ValueString(), data.CollectionName.ValueString())
+	if err != nil {
+		// Not found: remove from state
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	// A collection released outside of Terraform is loaded again by the next apply.
+	if state == CollectionReleased {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...) // Keep state
+}
+
+// Update is never called with a change, every attribute requires replacement.
+func (r *CollectionLoadResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CollectionLoadResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CollectionLoadResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CollectionLoadResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...) // Get current state
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.Collection(data.ConnectAddress.ValueString(), data.DbName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get collection client",
+			fmt.Sprintf("ConnectAddress: %s, error: %s", data.ConnectAddress.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	err = ensureCollectionLoadState(ctx, client, data.DbName.ValueString(), data.CollectionName.ValueString(), CollectionReleased)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to release collection",
+			fmt.Sprintf("ConnectAddress: %s, DbName: %s, CollectionName: %s, error: %s", data.ConnectAddress.ValueString(), data.DbName.ValueString(), data.CollectionName.ValueString(), err.Error()),
+		)
+		return
+	}
+}
+
+func (r *CollectionLoadResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Parse import ID, format: "/connections/{connect_address}/databases/{db_name}/collections/{collection_name}"
+	connectAddress, dbName, collectionName, ok := ParseCollectionID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Invalid import ID format",
+			"Import ID must be in the format '/connections/{connect_address}/databases/{db_name}/collections/{collection_name}'",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("connect_address"), "https://"+connectAddress)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("db_name"), dbName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("collection_name"), collectionName)...)
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+)
+
+func newTestCollectionLoadResource(t *testing.T, do func(call int, req *http.Request, body []byte) (*http.Response, error)) (*CollectionLoadResource, tfsdk.State) {
+	t.Helper()
+	ctx := context.Background()
+	client, err := zilliz.NewClient(
+		zilliz.WithApiKey("test-key"),
+		zilliz.WithHTTPClient(&volumeResourceMockHTTPClient{t: t, do: do}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	r := &CollectionLoadResource{client: client}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	state.Set(ctx, &CollectionLoadResourceModel{
+		Id:             types.StringValue("/connections/in01-test.zillizcloud.com:19530/databases/testdb/collections/testcollection"),
+		ConnectAddress: types.StringValue("https://in01-test.zillizcloud.com:19530"),
+		DbName:         types.StringValue("testdb"),
+		CollectionName: types.StringValue("testcollection"),
+	})
+	return r, state
+}
+
+func TestCollectionLoadResourceCreateRequiresIndex(t *testing.T) {
+	r, state := newTestCollectionLoadResource(t, func(call int, req *http.Request, body []byte) (*http.Response, error) {
+		if call != 1 {
+			return nil, fmt.Errorf("unexpected call %d to %s", call, req.URL.Path)
+		}
+		return describeCollectionResponse(t, zilliz.CollectionLoadStateNotLoad, 0), nil
+	})
+
+	plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+	resp := fwresource.CreateResponse{State: tfsdk.State{Schema: state.Schema, Raw: tftypes.NewValue(state.Schema.Type().TerraformType(context.Background()), nil)}}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: plan}, &resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Create succeeded, want an error for the collection without an index")
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Fatal("state was written for a collection that isn't loaded")
+	}
+}
+
+func TestCollectionLoadResourceReadRemovesReleasedCollection(t *testing.T) {
+	tests := map[string]bool{
+		zilliz.CollectionLoadStateLoaded:  false,
+		zilliz.CollectionLoadStateLoading: false,
+		zilliz.CollectionLoadStateNotLoad: true,
+	}
+	for load, wantRemoved := range tests {
+		r, state := newTestCollectionLoadResource(t, func(call int, req *http.Request, body []byte) (*http.Response, error) {
+			return describeCollectionResponse(t, load, 1), nil
+		})
+
+		resp := fwresource.ReadResponse{State: state}
+		r.Read(context.Background(), fwresource.ReadRequest{State: state}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Read diagnostics: %v", resp.Diagnostics)
+		}
+		if resp.State.Raw.IsNull() != wantRemoved {
+			t.Errorf("load %q: removed = %v, want %v", load, resp.State.Raw.IsNull(), wantRemoved)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+)
+
+func newTestCollectionClient(t *testing.T, do func(call int, req *http.Request, body []byte) (*http.Response, error)) *zilliz.ClientCollection {
+	t.Helper()
+	client, err := zilliz.NewClient(
+		zilliz.WithApiKey("test-key"),
+		zilliz.WithHTTPClient(&volumeResourceMockHTTPClient{t: t, do: do}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	collectionClient, err := client.Collection("https://in01-test.zillizcloud.com:19530", "testdb")
+	if err != nil {
+		t.Fatalf("Collection: %v", err)
+	}
+	return collectionClient
+}
+
+func describeCollectionResponse(t *testing.T, load string, indexes int) *http.Response {
+	t.Helper()
+	desc := zilliz.CollectionDescription{CollectionName: "testcollection", Load: load}
+	for i := 0; i < indexes; i++ {
+		desc.Indexes = append(desc.Indexes, zilliz.CollectionIndex{FieldName: "vector", IndexName: fmt.Sprintf("idx_%d", i)})
+	}
+	return volumeJSONResponse(t, http.StatusOK, map[string]any{"code": 0, "data": desc})
+}
+
+func TestCollectionLoadState(t *testing.T) {
+	tests := map[string]string{
+		zilliz.CollectionLoadStateLoaded:  CollectionLoaded,
+		zilliz.CollectionLoadStateLoading: collectionLoading,
+		zilliz.CollectionLoadStateNotLoad: CollectionReleased,
+		"LoadStateNotExist":               CollectionReleased,
+		"":                                CollectionReleased,
+	}
+	for load, want := range tests {
+		if got := collectionLoadState(load); got != want {
+			t.Errorf("collectionLoadState(%q) = %q, want %q", load, got, want)
+		}
+	}
+}
+
+func TestEnsureCollectionLoadStateLoadsAndWaits(t *testing.T) {
+	var paths []string
+	client := newTestCollectionClient(t, func(call int, req *http.Request, body []byte) (*http.Response, error) {
+		paths = append(paths, req.URL.Path)
+		var params map[string]any
+		if err := json.Unmarshal(body, &params); err != nil {
+			t.Fatalf("Unmarshal request: %v", err)
+		}
+		if params["dbName"] != "testdb" || params["collectionName"] != "testcollection" {
+			t.Fatalf("unexpected request params=%v", params)
+		}
+		switch call {
+		case 1:
+			return describeCollectionResponse(t, zilliz.CollectionLoadStateNotLoad, 1), nil
+		case 2:
+			return volumeJSONResponse(t, http.StatusOK, map[string]any{"code": 0, "data": map[string]any{}}), nil
+		case 3:
+			return describeCollectionResponse(t, zilliz.CollectionLoadStateLoading, 1), nil
+		case 4:
+			return describeCollectionResponse(t, zilliz.CollectionLoadStateLoaded, 1), nil
+		default:
+			return nil, fmt.Errorf("unexpected call %d", call)
+		}
+	})
+
+	if err := ensureCollectionLoadState(context.Background(), client, "testdb", "testcollection", CollectionLoaded); err != nil {
+		t.Fatalf("ensureCollectionLoadState: %v", err)
+	}
+	if len(paths) != 4 || paths[1] != "/v2/vectordb/collections/load" {
+		t.Fatalf("unexpected calls=%v", paths)
+	}
+}
+
+func TestEnsureCollectionLoadStateRequiresIndex(t *testing.T) {
+	client := newTestCollectionClient(t, func(call int, req *http.Request, body []byte) (*http.Response, error) {
+		if call != 1 {
+			return nil, fmt.Errorf("unexpected call %d to %s", call, req.URL.Path)
+		}
+		return describeCollectionResponse(t, zilliz.CollectionLoadStateNotLoad, 0), nil
+	})
+
+	err := ensureCollectionLoadState(context.Background(), client, "testdb", "testcollection", CollectionLoaded)
+	if !errors.Is(err, errCollectionNotIndexed) {
+		t.Fatalf("err=%v, want errCollectionNotIndexed", err)
+	}
+}
+
+func TestEnsureCollectionLoadStateReleases(t *testing.T) {
+	var paths []string
+	client := newTestCollectionClient(t, func(call int, req *http.Request, body []byte) (*http.Response, error) {
+		paths = append(paths, req.URL.Path)
+		switch call {
+		case 1:
+			return describeCollectionResponse(t, zilliz.CollectionLoadStateLoaded, 1), nil
+		case 2:
+			return volumeJSONResponse(t, http.StatusOK, map[string]any{"code": 0, "data": map[string]any{}}), nil
+		case 3:
+			return describeCollectionResponse(t, zilliz.CollectionLoadStateNotLoad, 1), nil
+		default:
+			return nil, fmt.Errorf("unexpected call %d", call)
+		}
+	})
+
+	if err := ensureCollectionLoadState(context.Background(), client, "testdb", "testcollection", CollectionReleased); err != nil {
+		t.Fatalf("ensureCollectionLoadState: %v", err)
+	}
+	if len(paths) != 3 || paths[1] != "/v2/vectordb/collections/release" {
+		t.Fatalf("unexpected calls=%v", paths)
+	}
+}
+
+func TestEnsureCollectionLoadStateNoop(t *testing.T) {
+	client := newTestCollectionClient(t, func(call int, req *http.Request, body []byte) (*http.Response, error) {
+		if call != 1 {
+			return nil, fmt.Errorf("unexpected call %d to %s", call, req.URL.Path)
+		}
+		return describeCollectionResponse(t, zilliz.CollectionLoadStateLoaded, 1), nil
+	})
+
+	if err := ensureCollectionLoadState(context.Background(), client, "testdb", "testcollection", CollectionLoaded); err != nil {
+		t.Fatalf("ensureCollectionLoadState: %v", err)
+	}
+}
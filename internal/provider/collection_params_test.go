@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+)
+
+func TestCreateCollectionRequestBody(t *testing.T) {
+	var body map[string]any
+	client := newTestCollectionClient(t, func(call int, req *http.Request, b []byte) (*http.Response, error) {
+		if err := json.Unmarshal(b, &body); err != nil {
+			t.Fatalf("Unmarshal request: %v", err)
+		}
+		return volumeJSONResponse(t, http.StatusOK, map[string]any{"code": 0, "data": map[string]any{}}), nil
+	})
+
+	err := client.CreateCollection(&zilliz.CreateCollectionParams{
+		CollectionName: "testcollection",
+		Description:    "orders",
+		Schema: zilliz.CollectionSchema{
+			EnabledDynamicField: true,
+			Fields: []zilliz.CollectionSchemaField{
+				{FieldName: "id", DataType: "Int64", IsPrimary: true},
+				{FieldName: "tenant", DataType: "VarChar", IsPartitionKey: true},
+			},
+		},
+		Params: map[string]any{"shardsNum": int64(4), "partitionsNum": int64(32)},
+	})
+	if err != nil {
+		t.Fatalf("CreateCollection: %v", err)
+	}
+
+	if body["description"] != "orders" {
+		t.Errorf("description = %v, want orders", body["description"])
+	}
+	schema := body["schema"].(map[string]any)
+	if schema["enableDynamicField"] != true {
+		t.Errorf("schema.enableDynamicField = %v, want true", schema["enableDynamicField"])
+	}
+	fields := schema["fields"].([]any)
+	if _, ok := fields[0].(map[string]any)["isPartitionKey"]; ok {
+		t.Errorf("isPartitionKey sent for a regular field")
+	}
+	if fields[1].(map[string]any)["isPartitionKey"] != true {
+		t.Errorf("fields[1].isPartitionKey = %v, want true", fields[1].(map[string]any)["isPartitionKey"])
+	}
+	params := body["params"].(map[string]any)
+	if params["shardsNum"] != float64(4) || params["partitionsNum"] != float64(32) {
+		t.Errorf("params = %v, want shardsNum 4 and partitionsNum 32", params)
+	}
+}
+
+func TestCollectionPopulateComputed(t *testing.T) {
+	desc := &zilliz.CollectionDescription{
+		Description:   "from server",
+		Load:          zilliz.CollectionLoadStateNotLoad,
+		ShardsNum:     1,
+		PartitionsNum: 16,
+	}
+
+	data := CollectionResourceModel{
+		Description: types.StringUnknown(),
+		LoadState:   types.StringValue(CollectionLoaded),
+		Params: &CollectionParamsModel{
+			ShardsNum:     types.Int64Value(4),
+			NumPartitions: types.Int64Unknown(),
+		},
+	}
+	if !data.hasUnknownComputed() {
+		t.Fatalf("hasUnknownComputed() = false, want true")
+	}
+	data.populateComputed(desc)
+
+	if data.Description.ValueString() != "from server" {
+		t.Errorf("Description = %s, want from server", data.Description)
+	}
+	// Known values stay as planned.
+	if data.LoadState.ValueString() != CollectionLoaded {
+		t.Errorf("LoadState = %s, want %s", data.LoadState, CollectionLoaded)
+	}
+	if data.Params.ShardsNum.ValueInt64() != 4 {
+		t.Errorf("ShardsNum = %s, want 4", data.Params.ShardsNum)
+	}
+	if data.Params.NumPartitions.ValueInt64() != 16 {
+		t.Errorf("NumPartitions = %s, want 16", data.Params.NumPartitions)
+	}
+	if data.hasUnknownComputed() {
+		t.Errorf("hasUnknownComputed() = true after populateComputed")
+	}
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+)
+
+func TestManagedCollectionProperties(t *testing.T) {
+	ctx := context.Background()
+	data := CollectionResourceModel{
+		Params: &CollectionParamsModel{
+			MMAPEnabled:           types.BoolValue(true),
+			TTLSeconds:            types.Int64Value(3600),
+			ConsistencyLevel:      types.StringValue("Bounded"),
+			PartitionKeyIsolation: types.BoolNull(),
+		},
+		Properties: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"collection.autocompaction.enabled": types.StringValue("false"),
+		}),
+	}
+
+	got, diags := managedCollectionProperties(ctx, &data)
+	if diags.HasError() {
+		t.Fatalf("managedCollectionProperties diagnostics: %v", diags)
+	}
+	want := map[string]string{
+		"mmap.enabled":                      "true",
+		"collection.ttl.seconds":            "3600",
+		"collection.autocompaction.enabled": "false",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("managedCollectionProperties = %v, want %v", got, want)
+	}
+}
+
+func TestDiffCollectionProperties(t *testing.T) {
+	set, drop, fieldSets := diffCollectionProperties(
+		map[string]string{"collection.ttl.seconds": "60", "mmap.enabled": "true", "unchanged": "1"},
+		map[string]string{"collection.ttl.seconds": "120", "unchanged": "1", "partitionkey.isolation": "true"},
+		map[string]map[string]string{"vector": {"mmap.enabled": "false"}, "title": {"max_length": "256"}},
+		map[string]map[string]string{"vector": {"mmap.enabled": "true"}, "title": {"max_length": "256"}},
+	)
+
+	if want := map[string]any{"collection.ttl.seconds": "120", "partitionkey.isolation": "true"}; !reflect.DeepEqual(set, want) {
+		t.Errorf("set = %v, want %v", set, want)
+	}
+	if want := []string{"mmap.enabled"}; !reflect.DeepEqual(drop, want) {
+		t.Errorf("drop = %v, want %v", drop, want)
+	}
+	if want := map[string]map[string]any{"vector": {"mmap.enabled": "true"}}; !reflect.DeepEqual(fieldSets, want) {
+		t.Errorf("fieldSets = %v, want %v", fieldSets, want)
+	}
+}
+
+func TestPropertiesRequireRelease(t *testing.T) {
+	tests := []struct {
+		name      string
+		set       map[string]any
+		drop      []string
+		fieldSets map[string]map[string]any
+		want      bool
+	}{
+		{name: "ttl only", set: map[string]any{"collection.ttl.seconds": "60"}, want: false},
+		{name: "collection mmap", set: map[string]any{"mmap.enabled": "true"}, want: true},
+		{name: "drop isolation", drop: []string{"partitionkey.isolation"}, want: true},
+		{name: "field mmap", fieldSets: map[string]map[string]any{"vector": {"mmap.enabled": "true"}}, want: true},
+		{name: "field max_length", fieldSets: map[string]map[string]any{"title": {"max_length": "512"}}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := propertiesRequireRelease(tt.set, tt.drop, tt.fieldSets); got != tt.want {
+				t.Errorf("propertiesRequireRelease = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAlterCollectionPropertiesReleasesAndReloads(t *testing.T) {
+	var paths []string
+	loaded := true
+	client := newTestCollectionClient(t, func(call int, req *http.Request, body []byte) (*http.Response, error) {
+		paths = append(paths, req.URL.Path)
+		ok := volumeJSONResponse(t, http.StatusOK, map[string]any{"code": 0, "data": map[string]any{}})
+		switch req.URL.Path {
+		case "/v2/vectordb/collections/describe":
+			if loaded {
+				return describeCollectionResponse(t, zilliz.CollectionLoadStateLoaded, 1), nil
+			}
+			return describeCollectionResponse(t, zilliz.CollectionLoadStateNotLoad, 1), nil
+		case "/v2/vectordb/collections/release":
+			loaded = false
+			return ok, nil
+		case "/v2/vectordb/collections/load":
+			loaded = true
+			return ok, nil
+		case "/v2/vectordb/collections/alter_properties":
+			if loaded {
+				t.Fatal("collection properties altered while loaded")
+			}
+			return ok, nil
+		case "/v2/vectordb/collections/fields/alter_properties":
+			var params zilliz.AlterFieldPropertiesParams
+			if err := json.Unmarshal(body, &params); err != nil {
+				t.Fatalf("Unmarshal request: %v", err)
+			}
+			if params.FieldName != "vector" || params.FieldParams["mmap.enabled"] != "true" {
+				t.Fatalf("unexpected field params=%+v", params)
+			}
+			return ok, nil
+		default:
+			return nil, fmt.Errorf("unexpected call %d to %s", call, req.URL.Path)
+		}
+	})
+
+	err := alterCollectionProperties(context.Background(), client, "testdb", "testcollection",
+		map[string]any{"mmap.enabled": "true"},
+		nil,
+		map[string]map[string]any{"vector": {"mmap.enabled": "true"}},
+	)
+	if err != nil {
+		t.Fatalf("alterCollectionProperties: %v", err)
+	}
+	if !loaded {
+		t.Fatal("collection was not loaded again")
+	}
+	want := []string{
+		"/v2/vectordb/collections/describe",
+		"/v2/vectordb/collections/describe",
+		"/v2/vectordb/collections/release",
+		"/v2/vectordb/collections/describe",
+		"/v2/vectordb/collections/alter_properties",
+		"/v2/vectordb/collections/fields/alter_properties",
+		"/v2/vectordb/collections/describe",
+		"/v2/vectordb/collections/load",
+		"/v2/vectordb/collections/describe",
+	}
+	if !reflect.DeepEqual(paths, want) {
+		t.Fatalf("calls = %v, want %v", paths, want)
+	}
+}
+
+func TestFlattenManagedProperties(t *testing.T) {
+	ctx := context.Background()
+	managed := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"collection.autocompaction.enabled": types.StringValue("false"),
+		"removed.on.server":                 types.StringValue("1"),
+	})
+
+	got, diags := flattenManagedProperties(ctx, managed, map[string]string{
+		"collection.autocompaction.enabled": "true",
+		"added.on.server":                   "x",
+	})
+	if diags.HasError() {
+		t.Fatalf("flattenManagedProperties diagnostics: %v", diags)
+	}
+	want := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"collection.autocompaction.enabled": types.StringValue("true"),
+	})
+	if !got.Equal(want) {
+		t.Fatalf("flattenManagedProperties = %v, want %v", got, want)
+	}
+}
@@ -2,16 +2,23 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"reflect"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
@@ -19,10 +26,26 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+	util "github.com/zilliztech/terraform-provider-zillizcloud/client/retry"
 )
 
+const (
+	CollectionLoaded   string = "Loaded"
+	CollectionReleased string = "Released"
+	collectionLoading  string = "Loading"
+
+	defaultCollectionLoadTimeout time.Duration = 10 * time.Minute
+
+	collectionPropertyMMAPEnabled           string = "mmap.enabled"
+	collectionPropertyTTLSeconds            string = "collection.ttl.seconds"
+	collectionPropertyPartitionKeyIsolation string = "partitionkey.isolation"
+)
+
+var errCollectionNotIndexed = errors.New("the collection has no index yet, Milvus can only load a collection after its vector fields are indexed")
+
 // NewCollectionResource returns a new collection resource.
 func NewCollectionResource() resource.Resource {
 	return &CollectionResource{}
@@ -37,14 +60,21 @@ type CollectionResourceModel struct {
 	ConnectAddress types.String           `tfsdk:"connect_address"`
 	DbName         types.String           `tfsdk:"db_name"`
 	CollectionName types.String           `tfsdk:"collection_name"`
+	Description    types.String           `tfsdk:"description"`
 	Schema         *CollectionSchemaModel `tfsdk:"schema"`
 	Params         *CollectionParamsModel `tfsdk:"params"`
+	LoadState      types.String           `tfsdk:"load_state"`
+	Properties     types.Map              `tfsdk:"properties"`
+	FieldProps     types.Map              `tfsdk:"field_properties"`
 }
 
 type CollectionParamsModel struct {
-	MMAPEnabled      types.Bool   `tfsdk:"mmap_enabled"`
-	TTLSeconds       types.Int64  `tfsdk:"ttl_seconds"`
-	ConsistencyLevel types.String `tfsdk:"consistency_level"`
+	MMAPEnabled           types.Bool   `tfsdk:"mmap_enabled"`
+	TTLSeconds            types.Int64  `tfsdk:"ttl_seconds"`
+	ConsistencyLevel      types.String `tfsdk:"consistency_level"`
+	PartitionKeyIsolation types.Bool   `tfsdk:"partition_key_isolation"`
+	ShardsNum             types.Int64  `tfsdk:"shards_num"`
+	NumPartitions         types.Int64  `tfsdk:"num_partitions"`
 }
 
 type CollectionSchemaModel struct {
@@ -58,10 +88,13 @@ type CollectionSchemaFieldModel struct {
 	DataType          types.String            `tfsdk:"data_type"`
 	ElementDataType   types.String            `tfsdk:"element_data_type"`
 	IsPrimary         types.Bool              `tfsdk:"is_primary"`
+	IsPartitionKey    types.Bool              `tfsdk:"is_partition_key"`
 	ElementTypeParams map[string]types.String `tfsdk:"element_type_params"`
 }
 
 var _ resource.ResourceWithImportState = &CollectionResource{}
+var _ resource.ResourceWithValidateConfig = &CollectionResource{}
+var _ resource.ResourceWithModifyPlan = &CollectionResource{}
 
 func (r *CollectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_collection"
@@ -70,7 +103,12 @@ func (r *CollectionResource) Metadata(ctx context.Context, req resource.Metadata
 func (r *CollectionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: `Manages a collection in a Zilliz Cloud database.
-The schema block must be defined inline. Changing the schema, db_name, or collection_name will force resource replacement.`,
+The schema block must be defined inline. Changing the schema, db_name, or collection_name will force resource replacement.
+Set load_state to keep the collection loaded into memory (searchable) or released.
+Collection and field properties (params, properties, field_properties) are updated in place.
+
+Existing collections can be imported with the ID ` + "`/connections/{connect_address}/databases/{db_name}/collections/{collection_name}`" + `.
+The schema, params and load state are read back from the cluster, with data type names mapped to the casing used here (for example ` + "`INT64`" + ` to ` + "`Int64`" + `).`,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -112,6 +150,68 @@ You can obtain this value from the output of the ` + "`zillizcloud_cluster`" + `
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: `A description of the collection. Changing this value will force resource replacement.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIf(stringReplaceIfStateSet, replaceIfStateSetDescription, replaceIfStateSetDescription),
+				},
+			},
+			"load_state": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: `The load state of the collection. Possible values are ` + "`Loaded`" + ` and ` + "`Released`" + `.
+When set, the provider loads or releases the collection and waits until the operation finishes.
+If the collection is loaded or released outside of Terraform, the change is reported as drift.
+
+> **Note:** Milvus can only load a collection whose vector fields are indexed, and a new collection has no index yet,
+so ` + "`Loaded`" + ` can't be set when the collection is created. To create, index and load a collection in a single apply,
+leave this unset and use the ` + "`zillizcloud_collection_load`" + ` resource, which can depend on the ` + "`zillizcloud_index`" + ` resources.`,
+				Validators: []validator.String{
+					stringvalidator.OneOf(CollectionLoaded, CollectionReleased),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"properties": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: `Additional collection properties, updated in place through the alter properties API.
+Use this for Milvus properties that have no dedicated attribute, for example ` + "`collection.autocompaction.enabled`" + `.
+Only the keys set here are managed: properties added on the server are ignored, while changes to managed keys are reported as drift.
+Removing a key drops the property from the collection.
+
+**Example:**
+
+` + "```" + `
+properties = {
+	"collection.autocompaction.enabled" = "false"
+}
+` + "```" + `
+
+> The keys managed by ` + "`params`" + ` (` + "`mmap.enabled`" + `, ` + "`collection.ttl.seconds`" + ` and ` + "`partitionkey.isolation`" + `) cannot be set here.`,
+			},
+			"field_properties": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.MapType{ElemType: types.StringType},
+				MarkdownDescription: `Per-field properties keyed by field name, updated in place through the alter field properties API.
+Use this for settings such as ` + "`mmap.enabled`" + ` on individual fields, or ` + "`max_length`" + ` on VarChar fields.
+Only the keys set here are managed. Removing a key stops managing it but leaves the current value on the server.
+A type param set here, such as ` + "`max_length`" + `, keeps its ` + "`element_type_params`" + ` value in state, so changing it doesn't replace the collection.
+
+**Example:**
+
+` + "```" + `
+field_properties = {
+	"vector" = {
+		"mmap.enabled" = "true"
+	}
+}
+` + "```",
+			},
 			"params": schema.SingleNestedAttribute{
 				Optional: true,
 				MarkdownDescription: `A JSON-formatted map of advanced params.
@@ -130,18 +230,51 @@ params = {
 				Attributes: map[string]schema.Attribute{
 					"mmap_enabled": schema.BoolAttribute{
 						Optional:            true,
-						MarkdownDescription: `Whether to enable memory-mapped files for the collection.`,
+						MarkdownDescription: `Whether to enable memory-mapped files for the collection. Updated in place; a loaded collection is released and loaded again to apply the change.`,
 					},
 					"ttl_seconds": schema.Int64Attribute{
 						Optional:            true,
-						MarkdownDescription: `Time-to-live (TTL) in seconds for the collection. After this period, the collection will be automatically deleted.`,
+						MarkdownDescription: `Time-to-live (TTL) in seconds for the collection. After this period, the collection will be automatically deleted. Updated in place.`,
 					},
 					"consistency_level": schema.StringAttribute{
 						Optional: true,
 						Computed: true,
 						Default:  stringdefault.StaticString("Bounded"),
 						MarkdownDescription: `The consistency level for the collection. Possible values are (Bounded|Strong|Session|Eventually). Defaults to "Bounded".
+Changing this value will force resource replacement.
 Reference: https://github.com/milvus-io/milvus-proto/blob/2.5/go-api/commonpb/common.pb.go#L1001`,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplaceIf(stringReplaceIfStateSet, replaceIfStateSetDescription, replaceIfStateSetDescription),
+						},
+					},
+					"shards_num": schema.Int64Attribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: `The number of shards of the collection. Defaults to the server setting. Changing this value will force resource replacement.`,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+							int64planmodifier.RequiresReplaceIf(int64ReplaceIfStateSet, replaceIfStateSetDescription, replaceIfStateSetDescription),
+						},
+					},
+					"num_partitions": schema.Int64Attribute{
+						Optional: true,
+						Computed: true,
+						MarkdownDescription: `The number of partitions of a collection with a partition key field (see ` + "`is_partition_key`" + `).
+Defaults to the server setting. Changing this value will force resource replacement.`,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+							int64planmodifier.RequiresReplaceIf(int64ReplaceIfStateSet, replaceIfStateSetDescription, replaceIfStateSetDescription),
+						},
+					},
+					"partition_key_isolation": schema.BoolAttribute{
+						Optional:            true,
+						MarkdownDescription: `Whether to enable partition key isolation for the collection. Updated in place; a loaded collection is released and loaded again to apply the change.`,
 					},
 				},
 			},
@@ -187,7 +320,7 @@ Reference: https://github.com/milvus-io/milvus-proto/blob/2.5/go-api/commonpb/co
 								},
 								"data_type": schema.StringAttribute{
 									Required:            true,
-									MarkdownDescription: `The data type of the field (e.g., "INT64", "FLOAT", "STRING", etc.).`,
+									MarkdownDescription: `The data type of the field, for example "Int64", "VarChar", "JSON", "Array" or "FloatVector". Matched case-insensitively.`,
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.RequiresReplace(),
 									},
@@ -208,6 +341,15 @@ Reference: https://github.com/milvus-io/milvus-proto/blob/2.5/go-api/commonpb/co
 										boolplanmodifier.RequiresReplace(),
 									},
 								},
+								"is_partition_key": schema.BoolAttribute{
+									Optional:            true,
+									Computed:            true,
+									MarkdownDescription: `Whether this field is the partition key of the collection. Use ` + "`params.num_partitions`" + ` to set the number of partitions.`,
+									Default:             booldefault.StaticBool(false),
+									PlanModifiers: []planmodifier.Bool{
+										boolplanmodifier.RequiresReplace(),
+									},
+								},
 								"element_type_params": schema.MapAttribute{
 									Optional:    true,
 									Computed:    true,
@@ -254,25 +396,111 @@ func convertSchemaFields(fields []CollectionSchemaFieldModel) []zilliz.Collectio
 		}
 		result[i] = zilliz.CollectionSchemaField{
 			FieldName:         f.FieldName.ValueString(),
-			DataType:          f.DataType.ValueString(),
-			ElementDataType:   f.ElementDataType.ValueString(),
+			DataType:          canonicalCollectionDataType(f.DataType.ValueString()),
+			ElementDataType:   canonicalCollectionDataType(f.ElementDataType.ValueString()),
 			IsPrimary:         f.IsPrimary.ValueBool(),
+			IsPartitionKey:    f.IsPartitionKey.ValueBool(),
 			ElementTypeParams: params,
 		}
 	}
 	return result
 }
+
+// collectionDataTypes lists the data type names the Milvus RESTful API accepts, keyed by their normalized form.
+var collectionDataTypes = map[string]string{}
+
+func init() {
+	for _, name := range []string{
+		"Bool", "Int8", "Int16", "Int32", "Int64", "Float", "Double", "VarChar", "JSON", "Array",
+		"BinaryVector", "FloatVector", "Float16Vector", "BFloat16Vector", "SparseFloatVector", "Int8Vector",
+	} {
+		collectionDataTypes[normalizeCollectionDataType(name)] = name
+	}
+}
+
+func normalizeCollectionDataType(name string) string {
+	name = strings.ToLower(strings.TrimPrefix(name, "DataType."))
+	return strings.ReplaceAll(name, "_", "")
+}
+
+// canonicalCollectionDataType maps a Milvus data type name in any casing, such as "INT64", "FLOAT_VECTOR"
+// or "DataType.VARCHAR", to the name accepted by the resource. Unknown names are returned unchanged.
+func canonicalCollectionDataType(name string) string {
+	if canonical, ok := collectionDataTypes[normalizeCollectionDataType(name)]; ok {
+		return canonical
+	}
+	return name
+}
+
+// preserveConfiguredDataTypes keeps the data type spelling of the prior fields when it only differs
+// in casing from what the server reports, so a refresh doesn't plan a replacement.
+func preserveConfiguredDataTypes(prior *CollectionSchemaModel, fields []CollectionSchemaFieldModel) {
+	if prior == nil {
+		return
+	}
+	for i := range fields {
+		for _, p := range prior.Fields {
+			if p.FieldName.ValueString() != fields[i].FieldName.ValueString() {
+				continue
+			}
+			if canonicalCollectionDataType(p.DataType.ValueString()) == fields[i].DataType.ValueString() {
+				fields[i].DataType = p.DataType
+			}
+			if !p.ElementDataType.IsNull() && canonicalCollectionDataType(p.ElementDataType.ValueString()) == fields[i].ElementDataType.ValueString() {
+				fields[i].ElementDataType = p.ElementDataType
+			}
+		}
+	}
+}
+
+// preserveManagedTypeParams keeps the prior element_type_params value of every key managed through
+// field_properties, such as max_length, so changing it there doesn't plan a replacement of the collection.
+func preserveManagedTypeParams(prior *CollectionSchemaModel, fieldProperties map[string]map[string]string, fields []CollectionSchemaFieldModel) {
+	for i := range fields {
+		managed := fieldProperties[fields[i].FieldName.ValueString()]
+		if len(managed) == 0 {
+			continue
+		}
+		var priorParams map[string]types.String
+		if prior != nil {
+			for _, p := range prior.Fields {
+				if p.FieldName.ValueString() == fields[i].FieldName.ValueString() {
+					priorParams = p.ElementTypeParams
+				}
+			}
+		}
+		for key := range managed {
+			if v, ok := priorParams[key]; ok {
+				fields[i].ElementTypeParams[key] = v
+			} else {
+				delete(fields[i].ElementTypeParams, key)
+			}
+		}
+	}
+}
+
 func convertSchemaFieldModel(field zilliz.CollectionField) CollectionSchemaFieldModel {
 	elementTypeParams := make(map[string]types.String)
 	for _, v := range field.Params {
+		// mmap.enabled is a field property managed through field_properties, not a type param.
+		if v.Key == collectionPropertyMMAPEnabled {
+			continue
+		}
 		elementTypeParams[v.Key] = types.StringValue(v.Value)
 	}
 
+	// Fields that aren't arrays have no element type; keep it null to match a configuration that omits it.
+	elementDataType := types.StringNull()
+	if field.ElementDataType != "" {
+		elementDataType = types.StringValue(canonicalCollectionDataType(field.ElementDataType))
+	}
+
 	return CollectionSchemaFieldModel{
 		FieldName:         types.StringValue(field.Name),
-		DataType:          types.StringValue(field.Type),
-		ElementDataType:   types.StringValue(field.ElementDataType),
+		DataType:          types.StringValue(canonicalCollectionDataType(field.Type)),
+		ElementDataType:   elementDataType,
 		IsPrimary:         types.BoolValue(field.PrimaryKey),
+		IsPartitionKey:    types.BoolValue(field.PartitionKey),
 		ElementTypeParams: elementTypeParams,
 	}
 }
@@ -307,11 +535,21 @@ func (r *CollectionResource) Create(ctx context.Context, req resource.CreateRequ
 		if !data.Params.ConsistencyLevel.IsNull() && !data.Params.ConsistencyLevel.IsUnknown() {
 			params["consistencyLevel"] = data.Params.ConsistencyLevel.ValueString()
 		}
+		if !data.Params.PartitionKeyIsolation.IsNull() && !data.Params.PartitionKeyIsolation.IsUnknown() {
+			params["partitionKeyIsolation"] = data.Params.PartitionKeyIsolation.ValueBool()
+		}
+		if !data.Params.ShardsNum.IsNull() && !data.Params.ShardsNum.IsUnknown() {
+			params["shardsNum"] = data.Params.ShardsNum.ValueInt64()
+		}
+		if !data.Params.NumPartitions.IsNull() && !data.Params.NumPartitions.IsUnknown() {
+			params["partitionsNum"] = data.Params.NumPartitions.ValueInt64()
+		}
 	}
 
 	err = client.CreateCollection(&zilliz.CreateCollectionParams{
 		DbName:         data.DbName.ValueString(),
 		CollectionName: data.CollectionName.ValueString(),
+		Description:    data.Description.ValueString(),
 		Schema: zilliz.CollectionSchema{
 			AutoID:              data.Schema.AutoID.ValueBool(),
 			EnabledDynamicField: data.Schema.EnabledDynamicField.ValueBool(),
@@ -327,9 +565,51 @@ func (r *CollectionResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	connectAddress = NormalizeConnectionID(connectAddress)
+	// The collection exists from here on, so failures below keep it in state and the next apply does not create it again.
+	data.Id = types.StringValue(BuildCollectionID(NormalizeConnectionID(connectAddress), data.DbName.ValueString(), data.CollectionName.ValueString()))
+
+	// Free-form properties are not accepted by the create API, so they are applied right after creation.
+	properties, diags := expandMapOfStrings(ctx, data.Properties)
+	resp.Diagnostics.Append(diags...)
+	fieldProperties, diags := expandFieldProperties(ctx, data.FieldProps)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	set, drop, fieldSets := diffCollectionProperties(nil, properties, nil, fieldProperties)
+	err = alterCollectionProperties(ctx, client, data.DbName.ValueString(), data.CollectionName.ValueString(), set, drop, fieldSets)
+	if err != nil {
+		created := data
+		created.Properties = types.MapNull(types.StringType)
+		created.FieldProps = types.MapNull(types.MapType{ElemType: types.StringType})
+		created.nullUnknownComputed()
+		resp.Diagnostics.Append(resp.State.Set(ctx, &created)...)
+		resp.Diagnostics.AddError(
+			"Failed to alter collection properties",
+			fmt.Sprintf("ConnectAddress: %s, DbName: %s, CollectionName: %s, error: %s", connectAddress, data.DbName.ValueString(), data.CollectionName.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// A new collection has no index yet, so it starts released. ModifyPlan rejects load_state = "Loaded" here,
+	// loading it in the same apply is left to zillizcloud_collection_load, which can depend on the indexes.
+
+	// Values left to the server (description, shard and partition counts, load state) are read back once the collection exists.
+	desc, err := client.DescribeCollection(&zilliz.DescribeCollectionParams{
+		DbName:         data.DbName.ValueString(),
+		CollectionName: data.CollectionName.ValueString(),
+	})
+	if err != nil {
+		data.nullUnknownComputed()
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.AddError(
+			"Failed to describe collection",
+			fmt.Sprintf("ConnectAddress: %s, DbName: %s, CollectionName: %s, error: %s", connectAddress, data.DbName.ValueString(), data.CollectionName.ValueString(), err.Error()),
+		)
+		return
+	}
+	data.populateComputed(desc)
 
-	data.Id = types.StringValue(BuildCollectionID(connectAddress, data.DbName.ValueString(), data.CollectionName.ValueString()))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...) // Save state
 }
 
@@ -365,39 +645,54 @@ func (r *CollectionResource) Read(ctx context.Context, req resource.ReadRequest,
 	for i, field := range desc.Fields {
 		fields[i] = convertSchemaFieldModel(field)
 	}
+	preserveConfiguredDataTypes(data.Schema, fields)
+	fieldProperties, diags := expandFieldProperties(ctx, data.FieldProps)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	preserveManagedTypeParams(data.Schema, fieldProperties, fields)
 	data.Schema = &CollectionSchemaModel{
 		AutoID:              types.BoolValue(desc.AutoID),
 		EnabledDynamicField: types.BoolValue(desc.EnableDynamicField),
 		Fields:              fields,
 	}
 	data.CollectionName = types.StringValue(desc.CollectionName)
+	data.Description = types.StringValue(desc.Description)
+	data.LoadState = types.StringValue(collectionLoadState(desc.Load))
 
 	// Only set params if it was originally configured by the user
 	if data.Params != nil {
 		// Initialize with current state values to preserve user configuration
 		params := &CollectionParamsModel{
-			MMAPEnabled:      data.Params.MMAPEnabled,
-			TTLSeconds:       data.Params.TTLSeconds,
-			ConsistencyLevel: data.Params.ConsistencyLevel,
+			MMAPEnabled:           data.Params.MMAPEnabled,
+			TTLSeconds:            data.Params.TTLSeconds,
+			ConsistencyLevel:      data.Params.ConsistencyLevel,
+			PartitionKeyIsolation: data.Params.PartitionKeyIsolation,
 		}
 
 		// Update with values from backend
 		params.ConsistencyLevel = types.StringValue(desc.ConsistencyLevel)
-
-		for _, prop := range desc.Properties {
-			if prop.Key == "mmap.enabled" {
-				v, _ := strconv.ParseBool(prop.Value)
-				params.MMAPEnabled = types.BoolValue(v)
-			}
-			if prop.Key == "collection.ttl.seconds" {
-				v, _ := strconv.ParseInt(prop.Value, 10, 64)
-				params.TTLSeconds = types.Int64Value(v)
-			}
-		}
+		params.ShardsNum = types.Int64Value(int64(desc.ShardsNum))
+		params.NumPartitions = types.Int64Value(int64(desc.PartitionsNum))
+		params.populateFromProperties(desc.Properties)
 
 		data.Params = params
 	}
 
+	// Only the keys managed by the configuration are compared, so properties added on the server don't show up as drift.
+	current := make(map[string]string, len(desc.Properties))
+	for _, prop := range desc.Properties {
+		current[prop.Key] = prop.Value
+	}
+	data.Properties, diags = flattenManagedProperties(ctx, data.Properties, current)
+	resp.Diagnostics.Append(diags...)
+	data.FieldProps, diags = flattenManagedFieldProperties(ctx, data.FieldProps, desc.Fields)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...) // Save state
 }
 
@@ -431,7 +726,7 @@ func (r *CollectionResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 }
 
-// update logic need to be drop and create.
+// Update alters collection properties in place. Schema changes force replacement and never reach Update.
 func (r *CollectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var state CollectionResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -454,46 +749,492 @@ func (r *CollectionResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	// Compare schema and other fields except params
-	schemaEqual := reflect.DeepEqual(state.Schema, plan.Schema) &&
-		state.DbName.ValueString() == plan.DbName.ValueString() &&
-		state.CollectionName.ValueString() == plan.CollectionName.ValueString() &&
-		state.ConnectAddress.ValueString() == plan.ConnectAddress.ValueString()
+	oldProperties, diags := managedCollectionProperties(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	newProperties, diags := managedCollectionProperties(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	oldFieldProperties, diags := expandFieldProperties(ctx, state.FieldProps)
+	resp.Diagnostics.Append(diags...)
+	newFieldProperties, diags := expandFieldProperties(ctx, plan.FieldProps)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	paramsEqual := reflect.DeepEqual(state.Params, plan.Params)
-	params := make(map[string]any)
-	if plan.Params != nil {
-		if !plan.Params.MMAPEnabled.IsNull() && !plan.Params.MMAPEnabled.IsUnknown() {
-			params["mmap.enabled"] = plan.Params.MMAPEnabled.ValueBool()
-		}
-		if !plan.Params.TTLSeconds.IsNull() && !plan.Params.TTLSeconds.IsUnknown() {
-			params["ttlSeconds"] = plan.Params.TTLSeconds.ValueInt64()
-		}
-		if !plan.Params.ConsistencyLevel.IsNull() && !plan.Params.ConsistencyLevel.IsUnknown() {
-			params["consistencyLevel"] = plan.Params.ConsistencyLevel.ValueString()
-		}
+	set, drop, fieldSets := diffCollectionProperties(oldProperties, newProperties, oldFieldProperties, newFieldProperties)
+	err = alterCollectionProperties(ctx, client, plan.DbName.ValueString(), plan.CollectionName.ValueString(), set, drop, fieldSets)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to alter collection properties",
+			fmt.Sprintf("ConnectAddress: %s, DbName: %s, CollectionName: %s, error: %s",
+				connectAddress, plan.DbName.ValueString(), plan.CollectionName.ValueString(), err.Error()),
+		)
+		return
 	}
 
-	if schemaEqual && !paramsEqual {
-		// Only params changed, use AlterCollectionProperties
-		err := client.AlterCollectionProperties(&zilliz.AlterCollectionPropertiesParams{
+	if !plan.LoadState.IsNull() && !plan.LoadState.IsUnknown() && !plan.LoadState.Equal(state.LoadState) {
+		err := ensureCollectionLoadState(ctx, client, plan.DbName.ValueString(), plan.CollectionName.ValueString(), plan.LoadState.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to change collection load state",
+				fmt.Sprintf("ConnectAddress: %s, DbName: %s, CollectionName: %s, error: %s",
+					connectAddress, plan.DbName.ValueString(), plan.CollectionName.ValueString(), err.Error()),
+			)
+			return
+		}
+	}
+	if plan.hasUnknownComputed() {
+		desc, err := client.DescribeCollection(&zilliz.DescribeCollectionParams{
 			DbName:         plan.DbName.ValueString(),
 			CollectionName: plan.CollectionName.ValueString(),
-			Properties:     params,
 		})
 		if err != nil {
 			resp.Diagnostics.AddError(
-				"Failed to alter collection properties",
+				"Failed to describe collection",
 				fmt.Sprintf("ConnectAddress: %s, DbName: %s, CollectionName: %s, error: %s",
 					connectAddress, plan.DbName.ValueString(), plan.CollectionName.ValueString(), err.Error()),
 			)
 			return
 		}
+		plan.populateComputed(desc)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+func (r *CollectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Only a new collection is checked, an existing one may already be indexed.
+	if !req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var loadState types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("load_state"), &loadState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if loadState.ValueString() == CollectionLoaded {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("load_state"),
+			"Collection can't be loaded on creation",
+			"A new collection has no index yet, and Milvus can only load an indexed collection. Leave load_state unset and add a "+
+				"zillizcloud_collection_load resource that depends on the collection's zillizcloud_index resources.",
+		)
+	}
+}
+
+func (r *CollectionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var numPartitions types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("params").AtName("num_partitions"), &numPartitions)...)
+	if !numPartitions.IsNull() && !numPartitions.IsUnknown() {
+		var fieldList types.List
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("schema").AtName("fields"), &fieldList)...)
+		var fields []CollectionSchemaFieldModel
+		if !fieldList.IsNull() && !fieldList.IsUnknown() {
+			resp.Diagnostics.Append(fieldList.ElementsAs(ctx, &fields, false)...)
+		}
+		hasPartitionKey := fieldList.IsUnknown()
+		for _, f := range fields {
+			// An unknown value may still turn out to be true, so only a known false is rejected.
+			if f.IsPartitionKey.IsUnknown() || f.IsPartitionKey.ValueBool() {
+				hasPartitionKey = true
+			}
+		}
+		if !resp.Diagnostics.HasError() && !hasPartitionKey {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("params").AtName("num_partitions"),
+				"Missing partition key",
+				"num_partitions can only be set on a collection with a field where is_partition_key = true.",
+			)
+		}
+	}
+
+	var properties types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("properties"), &properties)...)
+	if resp.Diagnostics.HasError() || properties.IsNull() || properties.IsUnknown() {
+		return
+	}
+
+	reserved := map[string]string{
+		collectionPropertyMMAPEnabled:           "params.mmap_enabled",
+		collectionPropertyTTLSeconds:            "params.ttl_seconds",
+		collectionPropertyPartitionKeyIsolation: "params.partition_key_isolation",
+	}
+	for key := range properties.Elements() {
+		if attribute, ok := reserved[key]; ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("properties").AtMapKey(key),
+				"Conflicting configuration",
+				fmt.Sprintf("The %q property is managed by %s. Set it there instead of in properties.", key, attribute),
+			)
+		}
+	}
+}
+
+// populateComputed fills the attributes left unknown in the plan with the values the server settled on.
+func (m *CollectionResourceModel) populateComputed(desc *zilliz.CollectionDescription) {
+	if m.Description.IsUnknown() {
+		m.Description = types.StringValue(desc.Description)
+	}
+	if m.LoadState.IsUnknown() {
+		m.LoadState = types.StringValue(collectionLoadState(desc.Load))
+	}
+	if m.Params == nil {
+		return
+	}
+	if m.Params.ShardsNum.IsUnknown() {
+		m.Params.ShardsNum = types.Int64Value(int64(desc.ShardsNum))
+	}
+	if m.Params.NumPartitions.IsUnknown() {
+		m.Params.NumPartitions = types.Int64Value(int64(desc.PartitionsNum))
+	}
+}
+
+// hasUnknownComputed reports whether any attribute filled in by populateComputed is still unknown.
+func (m *CollectionResourceModel) hasUnknownComputed() bool {
+	if m.Description.IsUnknown() || m.LoadState.IsUnknown() {
+		return true
+	}
+	return m.Params != nil && (m.Params.ShardsNum.IsUnknown() || m.Params.NumPartitions.IsUnknown())
+}
+
+// nullUnknownComputed clears the attributes populateComputed would fill in, so
+// a collection whose creation did not finish can be saved. Read fills them in.
+func (m *CollectionResourceModel) nullUnknownComputed() {
+	if m.Description.IsUnknown() {
+		m.Description = types.StringNull()
+	}
+	if m.LoadState.IsUnknown() {
+		m.LoadState = types.StringNull()
+	}
+	if m.Params == nil {
+		return
+	}
+	params := *m.Params
+	if params.ShardsNum.IsUnknown() {
+		params.ShardsNum = types.Int64Null()
+	}
+	if params.NumPartitions.IsUnknown() {
+		params.NumPartitions = types.Int64Null()
+	}
+	m.Params = &params
+}
+
+// populateFromProperties sets the params that Milvus reports as collection properties.
+func (p *CollectionParamsModel) populateFromProperties(properties []zilliz.CollectionProperty) {
+	for _, prop := range properties {
+		switch prop.Key {
+		case collectionPropertyMMAPEnabled:
+			v, _ := strconv.ParseBool(prop.Value)
+			p.MMAPEnabled = types.BoolValue(v)
+		case collectionPropertyTTLSeconds:
+			v, _ := strconv.ParseInt(prop.Value, 10, 64)
+			p.TTLSeconds = types.Int64Value(v)
+		case collectionPropertyPartitionKeyIsolation:
+			v, _ := strconv.ParseBool(prop.Value)
+			p.PartitionKeyIsolation = types.BoolValue(v)
+		}
+	}
+}
+
+// managedCollectionProperties returns the Milvus collection properties managed by params and properties.
+func managedCollectionProperties(ctx context.Context, data *CollectionResourceModel) (map[string]string, diag.Diagnostics) {
+	properties, diags := expandMapOfStrings(ctx, data.Properties)
+	if properties == nil {
+		properties = map[string]string{}
+	}
+	if data.Params != nil {
+		if !data.Params.MMAPEnabled.IsNull() && !data.Params.MMAPEnabled.IsUnknown() {
+			properties[collectionPropertyMMAPEnabled] = strconv.FormatBool(data.Params.MMAPEnabled.ValueBool())
+		}
+		if !data.Params.TTLSeconds.IsNull() && !data.Params.TTLSeconds.IsUnknown() {
+			properties[collectionPropertyTTLSeconds] = strconv.FormatInt(data.Params.TTLSeconds.ValueInt64(), 10)
+		}
+		if !data.Params.PartitionKeyIsolation.IsNull() && !data.Params.PartitionKeyIsolation.IsUnknown() {
+			properties[collectionPropertyPartitionKeyIsolation] = strconv.FormatBool(data.Params.PartitionKeyIsolation.ValueBool())
+		}
+	}
+	return properties, diags
+}
+
+const replaceIfStateSetDescription = "Changing this value on an existing collection requires replacement."
+
+// stringReplaceIfStateSet forces replacement only when a value already recorded in state changes,
+// so adding the attribute (or its enclosing block) to an existing collection only fills in the computed value.
+func stringReplaceIfStateSet(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.IsNull() && !req.PlanValue.IsUnknown() && !req.StateValue.Equal(req.PlanValue)
+}
+
+// int64ReplaceIfStateSet is the Int64 counterpart of stringReplaceIfStateSet.
+func int64ReplaceIfStateSet(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.IsNull() && !req.PlanValue.IsUnknown() && !req.StateValue.Equal(req.PlanValue)
+}
+
+func expandMapOfStrings(ctx context.Context, m types.Map) (map[string]string, diag.Diagnostics) {
+	if m.IsNull() || m.IsUnknown() {
+		return nil, nil
+	}
+	result := map[string]string{}
+	diags := m.ElementsAs(ctx, &result, false)
+	return result, diags
+}
+
+func expandFieldProperties(ctx context.Context, m types.Map) (map[string]map[string]string, diag.Diagnostics) {
+	if m.IsNull() || m.IsUnknown() {
+		return nil, nil
+	}
+	result := map[string]map[string]string{}
+	diags := m.ElementsAs(ctx, &result, false)
+	return result, diags
+}
+
+// diffCollectionProperties returns the collection properties to set, the collection property keys to drop
+// and the field properties to set when moving from the old to the new configuration.
+// Field properties can't be dropped, so keys removed from field_properties are simply no longer managed.
+func diffCollectionProperties(oldProperties, newProperties map[string]string, oldFieldProperties, newFieldProperties map[string]map[string]string) (map[string]any, []string, map[string]map[string]any) {
+	set := map[string]any{}
+	for k, v := range newProperties {
+		if old, ok := oldProperties[k]; !ok || old != v {
+			set[k] = v
+		}
+	}
+	var drop []string
+	for k := range oldProperties {
+		if _, ok := newProperties[k]; !ok {
+			drop = append(drop, k)
+		}
+	}
+	sort.Strings(drop)
+
+	fieldSets := map[string]map[string]any{}
+	for field, properties := range newFieldProperties {
+		for k, v := range properties {
+			if old, ok := oldFieldProperties[field][k]; ok && old == v {
+				continue
+			}
+			if fieldSets[field] == nil {
+				fieldSets[field] = map[string]any{}
+			}
+			fieldSets[field][k] = v
+		}
+	}
+	return set, drop, fieldSets
+}
+
+// propertiesRequireRelease reports whether Milvus requires the collection to be released before the change.
+func propertiesRequireRelease(set map[string]any, drop []string, fieldSets map[string]map[string]any) bool {
+	requiresRelease := func(key string) bool {
+		return key == collectionPropertyMMAPEnabled || key == collectionPropertyPartitionKeyIsolation
+	}
+	for k := range set {
+		if requiresRelease(k) {
+			return true
+		}
+	}
+	for _, k := range drop {
+		if requiresRelease(k) {
+			return true
+		}
+	}
+	for _, properties := range fieldSets {
+		for k := range properties {
+			if requiresRelease(k) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// alterCollectionProperties applies property changes. When a change can only be made on a released collection,
+// a loaded collection is released first and loaded again afterwards.
+func alterCollectionProperties(ctx context.Context, client *zilliz.ClientCollection, dbName, collectionName string, set map[string]any, drop []string, fieldSets map[string]map[string]any) error {
+	if len(set) == 0 && len(drop) == 0 && len(fieldSets) == 0 {
+		return nil
+	}
+
+	reload := false
+	if propertiesRequireRelease(set, drop, fieldSets) {
+		loadState, err := getCollectionLoadState(client, dbName, collectionName)
+		if err != nil {
+			return err
+		}
+		if loadState != CollectionReleased {
+			if err := ensureCollectionLoadState(ctx, client, dbName, collectionName, CollectionReleased); err != nil {
+				return fmt.Errorf("failed to release collection before altering properties: %w", err)
+			}
+			reload = true
+		}
+	}
+
+	if len(set) > 0 {
+		err := client.AlterCollectionProperties(&zilliz.AlterCollectionPropertiesParams{
+			DbName:         dbName,
+			CollectionName: collectionName,
+			Properties:     set,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if len(drop) > 0 {
+		err := client.DropCollectionProperties(&zilliz.DropCollectionPropertiesParams{
+			DbName:         dbName,
+			CollectionName: collectionName,
+			PropertyKeys:   drop,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	fields := make([]string, 0, len(fieldSets))
+	for field := range fieldSets {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		err := client.AlterFieldProperties(&zilliz.AlterFieldPropertiesParams{
+			DbName:         dbName,
+			CollectionName: collectionName,
+			FieldName:      field,
+			FieldParams:    fieldSets[field],
+		})
+		if err != nil {
+			return fmt.Errorf("field %s: %w", field, err)
+		}
+	}
+
+	if reload {
+		if err := ensureCollectionLoadState(ctx, client, dbName, collectionName, CollectionLoaded); err != nil {
+			return fmt.Errorf("failed to load collection after altering properties: %w", err)
+		}
+	}
+	return nil
+}
+
+// flattenManagedProperties refreshes the managed keys of properties with the values reported by the server.
+// A managed key that no longer exists on the server is removed, so the next plan restores it.
+func flattenManagedProperties(ctx context.Context, managed types.Map, current map[string]string) (types.Map, diag.Diagnostics) {
+	if managed.IsNull() || managed.IsUnknown() {
+		return managed, nil
+	}
+	elements := map[string]attr.Value{}
+	for k := range managed.Elements() {
+		if v, ok := current[k]; ok {
+			elements[k] = types.StringValue(v)
+		}
+	}
+	return types.MapValue(types.StringType, elements)
+}
+
+// flattenManagedFieldProperties refreshes the managed keys of field_properties with the field params reported by the server.
+func flattenManagedFieldProperties(ctx context.Context, managed types.Map, fields []zilliz.CollectionField) (types.Map, diag.Diagnostics) {
+	if managed.IsNull() || managed.IsUnknown() {
+		return managed, nil
+	}
+	var diags diag.Diagnostics
+	elements := map[string]attr.Value{}
+	for field, value := range managed.Elements() {
+		properties, ok := value.(types.Map)
+		if !ok {
+			continue
+		}
+		current := map[string]string{}
+		for _, f := range fields {
+			if f.Name != field {
+				continue
+			}
+			for _, p := range f.Params {
+				current[p.Key] = p.Value
+			}
+		}
+		refreshed, d := flattenManagedProperties(ctx, properties, current)
+		diags.Append(d...)
+		elements[field] = refreshed
+	}
+	result, d := types.MapValue(types.MapType{ElemType: types.StringType}, elements)
+	diags.Append(d...)
+	return result, diags
+}
+
+// collectionLoadState maps the load state reported by Milvus to the value exposed by load_state.
+func collectionLoadState(load string) string {
+	switch load {
+	case zilliz.CollectionLoadStateLoaded:
+		return CollectionLoaded
+	case zilliz.CollectionLoadStateLoading:
+		return collectionLoading
+	default:
+		return CollectionReleased
+	}
+}
+
+func getCollectionLoadState(client *zilliz.ClientCollection, dbName, collectionName string) (string, error) {
+	desc, err := client.DescribeCollection(&zilliz.DescribeCollectionParams{
+		DbName:         dbName,
+		CollectionName: collectionName,
+	})
+	if err != nil {
+		return "", err
+	}
+	return collectionLoadState(desc.Load), nil
+}
+
+// ensureCollectionLoadState loads or releases the collection and waits until it reaches the desired load state.
+func ensureCollectionLoadState(ctx context.Context, client *zilliz.ClientCollection, dbName, collectionName, desired string) error {
+	desc, err := client.DescribeCollection(&zilliz.DescribeCollectionParams{
+		DbName:         dbName,
+		CollectionName: collectionName,
+	})
+	if err != nil {
+		return err
+	}
+
+	current := collectionLoadState(desc.Load)
+	if current == desired {
+		return nil
+	}
+
+	switch desired {
+	case CollectionLoaded:
+		if len(desc.Indexes) == 0 {
+			return errCollectionNotIndexed
+		}
+		// A load that is already in progress only needs to be waited for.
+		if current != collectionLoading {
+			err = client.LoadCollection(&zilliz.LoadCollectionParams{
+				DbName:         dbName,
+				CollectionName: collectionName,
+			})
+		}
+	case CollectionReleased:
+		err = client.ReleaseCollection(&zilliz.ReleaseCollectionParams{
+			DbName:         dbName,
+			CollectionName: collectionName,
+		})
+	default:
+		return fmt.Errorf("unsupported load state %q", desired)
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = util.Poll(ctx, defaultCollectionLoadTimeout, func() (*string, *util.Err) {
+		state, err := getCollectionLoadState(client, dbName, collectionName)
+		if err != nil {
+			return nil, &util.Err{Err: err, Halt: !util.IsNetworkError(err)}
+		}
+		if state != desired {
+			return nil, &util.Err{
+				Err:  fmt.Errorf("collection not yet %s. Current state: %s", desired, state),
+				Halt: false,
+			}
+		}
+		return &state, nil
+	})
+	return err
+}
+
 // BuildCollectionID builds the ID for the collection resource.
 func BuildCollectionID(connectAddress, dbName, collectionName string) string {
 	return fmt.Sprintf("/connections/%s/databases/%s/collections/%s", connectAddress, dbName, collectionName)
@@ -554,18 +1295,10 @@ func (r *CollectionResource) ImportState(ctx context.Context, req resource.Impor
 	// Create params from backend data for import
 	params := &CollectionParamsModel{
 		ConsistencyLevel: types.StringValue(describe.ConsistencyLevel),
+		ShardsNum:        types.Int64Value(int64(describe.ShardsNum)),
+		NumPartitions:    types.Int64Value(int64(describe.PartitionsNum)),
 	}
-
-	for _, prop := range describe.Properties {
-		if prop.Key == "mmap.enabled" {
-			v, _ := strconv.ParseBool(prop.Value)
-			params.MMAPEnabled = types.BoolValue(v)
-		}
-		if prop.Key == "collection.ttl.seconds" {
-			v, _ := strconv.ParseInt(prop.Value, 10, 64)
-			params.TTLSeconds = types.Int64Value(v)
-		}
-	}
+	params.populateFromProperties(describe.Properties)
 
 	// Set import state with full details from backend
 	state := CollectionResourceModel{
@@ -573,12 +1306,16 @@ func (r *CollectionResource) ImportState(ctx context.Context, req resource.Impor
 		ConnectAddress: types.StringValue(connectAddressFull),
 		DbName:         types.StringValue(dbName),
 		CollectionName: types.StringValue(collectionName),
+		Description:    types.StringValue(describe.Description),
 		Schema: &CollectionSchemaModel{
 			AutoID:              types.BoolValue(describe.AutoID),
 			EnabledDynamicField: types.BoolValue(describe.EnableDynamicField),
 			Fields:              fields,
 		},
-		Params: params,
+		Params:     params,
+		LoadState:  types.StringValue(collectionLoadState(describe.Load)),
+		Properties: types.MapNull(types.StringType),
+		FieldProps: types.MapNull(types.MapType{ElemType: types.StringType}),
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -10,12 +10,7 @@ import (
 )
 
 func TestAccCollectionResource(t *testing.T) {
-	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
-		Steps: []resource.TestStep{
-			// Step 1: Create collection
-			{
-				Config: provider.ProviderConfig + `
+	collectionConfig := `
 resource "zillizcloud_database" "test" {
   connect_address = "https://in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534"
   db_name         = "testdb"
@@ -24,6 +19,7 @@ resource "zillizcloud_collection" "test" {
   connect_address = zillizcloud_database.test.connect_address
   db_name         = zillizcloud_database.test.db_name
   collection_name = "testcollection"
+  description     = "terraform acceptance test"
   schema = {
     auto_id = true
     enabled_dynamic_field = false
@@ -55,9 +51,16 @@ resource "zillizcloud_collection" "test" {
     mmap_enabled = true
     ttl_seconds = 86400
     consistency_level = "Bounded"
+    shards_num = 2
   }
 }
-`,
+`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Step 1: Create collection
+			{
+				Config: provider.ProviderConfig + collectionConfig,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("zillizcloud_collection.test", "connect_address", "https://in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534"),
 					resource.TestCheckResourceAttr("zillizcloud_collection.test", "db_name", "testdb"),
@@ -66,6 +69,9 @@ resource "zillizcloud_collection" "test" {
 					resource.TestCheckResourceAttr("zillizcloud_collection.test", "schema.fields.2.data_type", "Array"),
 					resource.TestCheckResourceAttr("zillizcloud_collection.test", "schema.fields.2.element_data_type", "VarChar"),
 					resource.TestCheckResourceAttr("zillizcloud_collection.test", "schema.fields.2.element_type_params.max_length", "128"),
+					resource.TestCheckResourceAttr("zillizcloud_collection.test", "load_state", "Released"),
+					resource.TestCheckResourceAttr("zillizcloud_collection.test", "description", "terraform acceptance test"),
+					resource.TestCheckResourceAttr("zillizcloud_collection.test", "params.shards_num", "2"),
 					resource.TestCheckResourceAttrSet("zillizcloud_collection.test", "id"),
 				),
 			},
@@ -117,10 +123,9 @@ resource "zillizcloud_collection" "test" {
 			},
 			// Step 3: Import collection
 			{
-				ResourceName:            "zillizcloud_collection.test",
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"schema"},
+				ResourceName:      "zillizcloud_collection.test",
+				ImportState:       true,
+				ImportStateVerify: true,
 				ImportStateIdFunc: func(state *terraform.State) (string, error) {
 					rs, ok := state.RootModule().Resources["zillizcloud_collection.test"]
 					if !ok {
@@ -133,6 +138,20 @@ resource "zillizcloud_collection" "test" {
 					return fmt.Sprintf("/connections/%s/databases/%s/collections/%s", connectAddress, dbName, collectionName), nil
 				},
 			},
+			// Step 4: An imported collection produces a clean plan against the same configuration
+			{
+				ResourceName:       "zillizcloud_collection.test",
+				ImportState:        true,
+				ImportStatePersist: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					rs := state.RootModule().Resources["zillizcloud_collection.test"]
+					return rs.Primary.ID, nil
+				},
+			},
+			{
+				Config:   provider.ProviderConfig + collectionConfig,
+				PlanOnly: true,
+			},
 		},
 	})
 }
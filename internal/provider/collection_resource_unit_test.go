@@ -0,0 +1,280 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+)
+
+func TestCollectionResourceImportRebuildsSchema(t *testing.T) {
+	ctx := context.Background()
+	client, err := zilliz.NewClient(
+		zilliz.WithApiKey("test-key"),
+		zilliz.WithHTTPClient(&volumeResourceMockHTTPClient{t: t, do: func(call int, req *http.Request, body []byte) (*http.Response, error) {
+			if req.URL.Path != "/v2/vectordb/collections/describe" {
+				t.Fatalf("unexpected request %s", req.URL.Path)
+			}
+			return volumeJSONResponse(t, http.StatusOK, map[string]any{"code": 0, "data": map[string]any{
+				"collectionName":     "docs",
+				"autoId":             true,
+				"enableDynamicField": true,
+				"consistencyLevel":   "Strong",
+				"shardsNum":          2,
+				"partitionsNum":      1,
+				"load":               zilliz.CollectionLoadStateNotLoad,
+				"fields": []map[string]any{
+					{"name": "id", "type": "INT64", "primaryKey": true, "autoId": true},
+					{"name": "embedding", "type": "FLOAT_VECTOR", "params": []map[string]any{{"key": "dim", "value": "768"}}},
+					{"name": "tags", "type": "ARRAY", "elementDataType": "VARCHAR", "params": []map[string]any{
+						{"key": "max_length", "value": "64"},
+						{"key": "max_capacity", "value": "16"},
+					}},
+				},
+				"properties": []map[string]any{{"key": "collection.ttl.seconds", "value": "3600"}},
+			}}), nil
+		}}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	r := &CollectionResource{client: client}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	resp := fwresource.ImportStateResponse{State: tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}}
+	r.ImportState(ctx, fwresource.ImportStateRequest{ID: "/connections/in01-test.zillizcloud.com:19530/databases/mydb/collections/docs"}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("ImportState: %v", resp.Diagnostics)
+	}
+
+	var got CollectionResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("State.Get: %v", resp.Diagnostics)
+	}
+	if got.ConnectAddress.ValueString() != "https://in01-test.zillizcloud.com:19530" || got.DbName.ValueString() != "mydb" {
+		t.Errorf("connect_address = %q, db_name = %q", got.ConnectAddress.ValueString(), got.DbName.ValueString())
+	}
+	if got.Schema == nil || !got.Schema.AutoID.ValueBool() || !got.Schema.EnabledDynamicField.ValueBool() || len(got.Schema.Fields) != 3 {
+		t.Fatalf("schema = %+v", got.Schema)
+	}
+	id, embedding, tags := got.Schema.Fields[0], got.Schema.Fields[1], got.Schema.Fields[2]
+	if id.DataType.ValueString() != "Int64" || !id.IsPrimary.ValueBool() || !id.ElementDataType.IsNull() {
+		t.Errorf("id field = %+v", id)
+	}
+	if embedding.DataType.ValueString() != "FloatVector" || embedding.ElementTypeParams["dim"].ValueString() != "768" {
+		t.Errorf("embedding field = %+v", embedding)
+	}
+	if tags.DataType.ValueString() != "Array" || tags.ElementDataType.ValueString() != "VarChar" || tags.ElementTypeParams["max_capacity"].ValueString() != "16" {
+		t.Errorf("tags field = %+v", tags)
+	}
+	if got.Params == nil || got.Params.ConsistencyLevel.ValueString() != "Strong" || got.Params.ShardsNum.ValueInt64() != 2 || got.Params.TTLSeconds.ValueInt64() != 3600 {
+		t.Errorf("params = %+v", got.Params)
+	}
+	if got.LoadState.ValueString() != CollectionReleased {
+		t.Errorf("load_state = %q", got.LoadState.ValueString())
+	}
+}
+
+// createTestCollection runs Create for a collection with a primary key and a
+// vector field against a server answering with do.
+func createTestCollection(t *testing.T, properties types.Map, do func(call int, req *http.Request, body []byte) (*http.Response, error)) (fwresource.CreateResponse, CollectionResourceModel) {
+	t.Helper()
+	ctx := context.Background()
+	client, err := zilliz.NewClient(
+		zilliz.WithApiKey("test-key"),
+		zilliz.WithHTTPClient(&volumeResourceMockHTTPClient{t: t, do: do}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	r := &CollectionResource{client: client}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	plan.Set(ctx, &CollectionResourceModel{
+		Id:             types.StringUnknown(),
+		ConnectAddress: types.StringValue("https://in01-test.zillizcloud.com:19530"),
+		DbName:         types.StringValue("testdb"),
+		CollectionName: types.StringValue("testcollection"),
+		Description:    types.StringUnknown(),
+		Schema: &CollectionSchemaModel{
+			AutoID:              types.BoolValue(false),
+			EnabledDynamicField: types.BoolValue(false),
+			Fields: []CollectionSchemaFieldModel{
+				{FieldName: types.StringValue("id"), DataType: types.StringValue("Int64"), ElementDataType: types.StringNull(), IsPrimary: types.BoolValue(true), IsPartitionKey: types.BoolValue(false), ElementTypeParams: map[string]types.String{}},
+				{FieldName: types.StringValue("vector"), DataType: types.StringValue("FloatVector"), ElementDataType: types.StringNull(), IsPrimary: types.BoolValue(false), IsPartitionKey: types.BoolValue(false), ElementTypeParams: map[string]types.String{"dim": types.StringValue("4")}},
+			},
+		},
+		LoadState:  types.StringUnknown(),
+		Properties: properties,
+		FieldProps: types.MapNull(types.MapType{ElemType: types.StringType}),
+	})
+
+	resp := fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, &resp)
+
+	var got CollectionResourceModel
+	if diags := resp.State.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("State.Get: %v", diags)
+	}
+	return resp, got
+}
+
+func TestCollectionResourceCreateReportsReleasedLoadState(t *testing.T) {
+	resp, got := createTestCollection(t, types.MapNull(types.StringType), func(call int, req *http.Request, body []byte) (*http.Response, error) {
+		switch req.URL.Path {
+		case "/v2/vectordb/collections/create":
+			return volumeJSONResponse(t, http.StatusOK, map[string]any{"code": 0, "data": map[string]any{}}), nil
+		case "/v2/vectordb/collections/describe":
+			return describeCollectionResponse(t, zilliz.CollectionLoadStateNotLoad, 0), nil
+		}
+		t.Fatalf("unexpected request %s", req.URL.Path)
+		return nil, nil
+	})
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create diagnostics: %v", resp.Diagnostics)
+	}
+	if got.LoadState.ValueString() != CollectionReleased {
+		t.Fatalf("load_state = %s, want the released state of the new collection", got.LoadState)
+	}
+}
+
+func TestCollectionResourceModifyPlanRejectsLoadedOnCreate(t *testing.T) {
+	ctx := context.Background()
+	r := &CollectionResource{}
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	nullState := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+
+	for loadState, wantError := range map[string]bool{CollectionLoaded: true, CollectionReleased: false} {
+		plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: nullState.Raw}
+		if diags := plan.SetAttribute(ctx, path.Root("load_state"), loadState); diags.HasError() {
+			t.Fatalf("SetAttribute: %v", diags)
+		}
+		resp := fwresource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{State: nullState, Plan: plan}, &resp)
+		if resp.Diagnostics.HasError() != wantError {
+			t.Errorf("load_state = %q on create: diagnostics = %v, want error %v", loadState, resp.Diagnostics, wantError)
+		}
+	}
+}
+
+func TestCollectionResourceCreateKeepsCollectionWhenPropertiesFail(t *testing.T) {
+	properties := types.MapValueMust(types.StringType, map[string]attr.Value{"owner": types.StringValue("search-team")})
+	resp, got := createTestCollection(t, properties, func(call int, req *http.Request, body []byte) (*http.Response, error) {
+		switch req.URL.Path {
+		case "/v2/vectordb/collections/create":
+			return volumeJSONResponse(t, http.StatusOK, map[string]any{"code": 0, "data": map[string]any{}}), nil
+		case "/v2/vectordb/collections/alter_properties":
+			return volumeJSONResponse(t, http.StatusOK, map[string]any{"code": 1100, "message": "invalid property"}), nil
+		}
+		t.Fatalf("unexpected request %s", req.URL.Path)
+		return nil, nil
+	})
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Create succeeded, want the properties error")
+	}
+	if got.Id.ValueString() != "/connections/in01-test.zillizcloud.com:19530/databases/testdb/collections/testcollection" {
+		t.Fatalf("id = %q, want the created collection in state", got.Id.ValueString())
+	}
+	if !got.Properties.IsNull() {
+		t.Fatalf("properties = %s, want the pre-alter value", got.Properties)
+	}
+}
+
+func TestCollectionResourceReadKeepsFieldPropertiesOutOfTypeParams(t *testing.T) {
+	ctx := context.Background()
+	client, err := zilliz.NewClient(
+		zilliz.WithApiKey("test-key"),
+		zilliz.WithHTTPClient(&volumeResourceMockHTTPClient{t: t, do: func(call int, req *http.Request, body []byte) (*http.Response, error) {
+			if req.URL.Path != "/v2/vectordb/collections/describe" {
+				t.Fatalf("unexpected request %s", req.URL.Path)
+			}
+			// max_length was raised to 512 through field_properties.
+			return volumeJSONResponse(t, http.StatusOK, map[string]any{"code": 0, "data": zilliz.CollectionDescription{
+				CollectionName: "testcollection",
+				Load:           zilliz.CollectionLoadStateLoaded,
+				Fields: []zilliz.CollectionField{
+					{Name: "id", Type: "Int64", PrimaryKey: true},
+					{Name: "title", Type: "VarChar", Params: []zilliz.FieldParam{{Key: "max_length", Value: "512"}}},
+				},
+			}}), nil
+		}}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	r := &CollectionResource{client: client}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	fieldProps, diags := types.MapValueFrom(ctx, types.MapType{ElemType: types.StringType}, map[string]map[string]string{
+		"title": {"max_length": "512"},
+	})
+	if diags.HasError() {
+		t.Fatalf("MapValueFrom: %v", diags)
+	}
+	configured := map[string]types.String{"max_length": types.StringValue("256")}
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	state.Set(ctx, &CollectionResourceModel{
+		Id:             types.StringValue("in01-test/testdb/testcollection"),
+		ConnectAddress: types.StringValue("https://in01-test.zillizcloud.com:19530"),
+		DbName:         types.StringValue("testdb"),
+		CollectionName: types.StringValue("testcollection"),
+		Description:    types.StringValue(""),
+		Schema: &CollectionSchemaModel{
+			AutoID:              types.BoolValue(false),
+			EnabledDynamicField: types.BoolValue(false),
+			Fields: []CollectionSchemaFieldModel{
+				{FieldName: types.StringValue("id"), DataType: types.StringValue("Int64"), ElementDataType: types.StringNull(), IsPrimary: types.BoolValue(true), IsPartitionKey: types.BoolValue(false), ElementTypeParams: map[string]types.String{}},
+				{FieldName: types.StringValue("title"), DataType: types.StringValue("VarChar"), ElementDataType: types.StringNull(), IsPrimary: types.BoolValue(false), IsPartitionKey: types.BoolValue(false), ElementTypeParams: configured},
+			},
+		},
+		LoadState:  types.StringValue(CollectionLoaded),
+		Properties: types.MapNull(types.StringType),
+		FieldProps: fieldProps,
+	})
+
+	resp := fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read diagnostics: %v", resp.Diagnostics)
+	}
+	var got CollectionResourceModel
+	if diags := resp.State.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("State.Get: %v", diags)
+	}
+	if !got.FieldProps.Equal(fieldProps) {
+		t.Errorf("field_properties = %v, want %v", got.FieldProps, fieldProps)
+	}
+
+	// Planning the unchanged configuration against the refreshed state must not replace the collection.
+	refreshed, diags := types.MapValueFrom(ctx, types.StringType, got.Schema.Fields[1].ElementTypeParams)
+	if diags.HasError() {
+		t.Fatalf("MapValueFrom: %v", diags)
+	}
+	planned, _ := types.MapValueFrom(ctx, types.StringType, configured)
+	planReq := planmodifier.MapRequest{StateValue: refreshed, PlanValue: planned, ConfigValue: planned, State: resp.State, Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: resp.State.Raw}}
+	planResp := &planmodifier.MapResponse{PlanValue: planned}
+	mapplanmodifier.RequiresReplace().PlanModifyMap(ctx, planReq, planResp)
+	if planResp.RequiresReplace {
+		t.Errorf("element_type_params = %v after read, plan replaces the collection", refreshed)
+	}
+}
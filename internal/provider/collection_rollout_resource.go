@@ -0,0 +1,752 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+	util "github.com/zilliztech/terraform-provider-zillizcloud/client/retry"
+)
+
+const defaultCollectionRolloutTimeout = 30 * time.Minute
+
+// NewCollectionRolloutResource returns a new collection rollout resource.
+func NewCollectionRolloutResource() resource.Resource {
+	return &CollectionRolloutResource{}
+}
+
+type CollectionRolloutResource struct {
+	client *zilliz.Client
+}
+
+type CollectionRolloutResourceModel struct {
+	Id               types.String                  `tfsdk:"id"` // /connections/{connect_address}/databases/{db_name}/rollouts/{alias_name}
+	ConnectAddress   types.String                  `tfsdk:"connect_address"`
+	DbName           types.String                  `tfsdk:"db_name"`
+	AliasName        types.String                  `tfsdk:"alias_name"`
+	Version          types.String                  `tfsdk:"version"`
+	ActiveVersion    types.String                  `tfsdk:"active_version"`
+	RetainVersions   types.Int64                   `tfsdk:"retain_versions"`
+	Schema           *CollectionSchemaModel        `tfsdk:"schema"`
+	Indexes          []CollectionRolloutIndexModel `tfsdk:"indexes"`
+	ActiveCollection types.String                  `tfsdk:"active_collection"`
+	Collections      types.List                    `tfsdk:"collections"`
+	Timeouts         timeouts.Value                `tfsdk:"timeouts"`
+}
+
+type CollectionRolloutIndexModel struct {
+	FieldName  types.String `tfsdk:"field_name"`
+	IndexName  types.String `tfsdk:"index_name"`
+	IndexType  types.String `tfsdk:"index_type"`
+	MetricType types.String `tfsdk:"metric_type"`
+	Params     types.Map    `tfsdk:"params"`
+}
+
+var _ resource.ResourceWithModifyPlan = &CollectionRolloutResource{}
+
+func (r *CollectionRolloutResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collection_rollout"
+}
+
+func (r *CollectionRolloutResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages blue/green cutovers of a collection behind an alias.
+
+Each ` + "`version`" + ` is a separate collection named ` + "`{alias_name}_{version}`" + `.
+Setting a new ` + "`version`" + ` creates that collection with the given schema and indexes, waits until its indexes are built and it is loaded,
+then retargets the alias with the alter aliases API so clients never see the alias missing.
+Previous versions are released and kept for rollback according to ` + "`retain_versions`" + `; older ones are dropped.
+
+To backfill a new version before it takes traffic, set ` + "`active_version`" + ` to the current version while creating the new one,
+then remove it (or set it to the new version) to cut over.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: `The unique identifier for the rollout resource.
+
+**Format:**
+
+` + "`/connections/{connect_address}/databases/{db_name}/rollouts/{alias_name}`" + `
+
+> **Note:** This value is automatically set and should not be manually specified.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connect_address": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: `The connection address of the target Zilliz Cloud cluster.
+You can obtain this value from the output of the ` + "`zillizcloud_cluster`" + ` resource, for example:
+` + "`zillizcloud_cluster.example.connect_address`" + `
+
+> **Note:** The address must include the protocol (e.g., ` + "`https://`" + `).`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"db_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: `The name of the database containing the alias and its collections.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"alias_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: `The name of the alias clients use. It is also the prefix of every version's collection name.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: `The latest version of the collection, for example ` + "`v2`" + `. Changing it creates the collection ` + "`{alias_name}_{version}`" + `.
+Setting it back to a version that is still retained reuses that collection as-is, which rolls back without a rebuild.`,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Za-z0-9_]+$`), "must contain only letters, numbers and underscores"),
+				},
+			},
+			"active_version": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: `The version the alias points to. Defaults to ` + "`version`" + `.
+Set it to the current version while a new ` + "`version`" + ` is being backfilled. It must be ` + "`version`" + ` or a retained version.`,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Za-z0-9_]+$`), "must contain only letters, numbers and underscores"),
+				},
+			},
+			"retain_versions": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				MarkdownDescription: `The number of versions older than the active one to keep (released) for rollback. Older versions are dropped. Defaults to 1.`,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"schema": schema.SingleNestedAttribute{
+				Required: true,
+				MarkdownDescription: `The schema of the collection created for a new version.
+Changing it requires a new ` + "`version`" + `; existing versions keep the schema they were created with.`,
+				Attributes: map[string]schema.Attribute{
+					"auto_id": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+						MarkdownDescription: `Whether to enable automatic ID generation for the collection.`,
+					},
+					"enabled_dynamic_field": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+						MarkdownDescription: `Whether to enable dynamic fields for the collection.`,
+					},
+					"fields": schema.ListNestedAttribute{
+						Required:            true,
+						MarkdownDescription: `List of field definitions for the collection schema.`,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"field_name": schema.StringAttribute{
+									Required:            true,
+									MarkdownDescription: `The name of the field.`,
+								},
+								"data_type": schema.StringAttribute{
+									Required:            true,
+									MarkdownDescription: `The data type of the field (e.g., "Int64", "FloatVector", "VarChar", etc.).`,
+								},
+								"element_data_type": schema.StringAttribute{
+									Optional:            true,
+									MarkdownDescription: `The data type of array elements (required when data_type is "Array").`,
+								},
+								"is_primary": schema.BoolAttribute{
+									Optional:            true,
+									Computed:            true,
+									Default:             booldefault.StaticBool(false),
+									MarkdownDescription: `Whether this field is the primary key.`,
+								},
+								"is_partition_key": schema.BoolAttribute{
+									Optional:            true,
+									Computed:            true,
+									Default:             booldefault.StaticBool(false),
+									MarkdownDescription: `Whether this field is the partition key of the collection.`,
+								},
+								"element_type_params": schema.MapAttribute{
+									Optional:    true,
+									Computed:    true,
+									ElementType: types.StringType,
+									Default: mapdefault.StaticValue(types.MapValueMust(
+										types.StringType,
+										map[string]attr.Value{},
+									)),
+									MarkdownDescription: `Additional parameters for the field type (e.g., ` + "`dim`" + ` or ` + "`max_length`" + `).`,
+								},
+							},
+						},
+					},
+				},
+			},
+			"indexes": schema.ListNestedAttribute{
+				Required: true,
+				MarkdownDescription: `The indexes built on a new version before the alias is moved to it.
+Changing them requires a new ` + "`version`" + `.`,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"field_name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: `The name of the field to be indexed.`,
+						},
+						"index_name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: `The name of the index.`,
+						},
+						"index_type": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: `The type of the index (e.g., "AUTOINDEX", "HNSW", etc.).`,
+						},
+						"metric_type": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: `The metric type for the index (e.g., "L2", "IP", "COSINE").`,
+						},
+						"params": schema.MapAttribute{
+							Optional:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: `Additional build parameters for the index (e.g., ` + "`M`" + ` and ` + "`efConstruction`" + ` for HNSW).`,
+						},
+					},
+				},
+			},
+			"active_collection": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: `The name of the collection the alias currently points to.`,
+			},
+			"collections": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: `The collections managed by this rollout, newest first.`,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx,
+				timeouts.Opts{
+					Create: true,
+					CreateDescription: `Timeout defaults to 30 mins. Accepts a string that can be [parsed as a duration](https://pkg.go.dev/time#ParseDuration) ` +
+						`consisting of numbers and unit suffixes, such as "30s" or "2h45m". Valid time units are ` +
+						`"s" (seconds), "m" (minutes), "h" (hours).`,
+					Update: true,
+					UpdateDescription: `Timeout defaults to 30 mins. Accepts a string that can be [parsed as a duration](https://pkg.go.dev/time#ParseDuration) ` +
+						`consisting of numbers and unit suffixes, such as "30s" or "2h45m". Valid time units are ` +
+						`"s" (seconds), "m" (minutes), "h" (hours).`,
+				},
+			),
+		},
+	}
+}
+
+func (r *CollectionRolloutResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*zilliz.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected provider type",
+			fmt.Sprintf("Expected *zilliz.Client, got: %T. Please check provider configuration.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func BuildCollectionRolloutID(connectAddress, dbName, aliasName string) string {
+	return fmt.Sprintf("/connections/%s/databases/%s/rollouts/%s", connectAddress, dbName, aliasName)
+}
+
+// rolloutCollectionName returns the name of the collection backing a version.
+func rolloutCollectionName(aliasName, version string) string {
+	return aliasName + "_" + version
+}
+
+// rolloutCollections orders the collections kept after a rollout, newest first.
+// Everything from the target down to the active collection is kept, plus retain older versions.
+func rolloutCollections(target, active string, existing []string, retain int) []string {
+	collections := []string{target}
+	for _, name := range existing {
+		if name != target {
+			collections = append(collections, name)
+		}
+	}
+	if keep := slices.Index(collections, active) + 1 + retain; keep < len(collections) {
+		collections = collections[:keep]
+	}
+	return collections
+}
+
+func (r *CollectionRolloutResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan CollectionRolloutResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	var configActive types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("active_version"), &configActive)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if configActive.IsNull() {
+		plan.ActiveVersion = plan.Version
+	}
+	if plan.AliasName.IsUnknown() || plan.Version.IsUnknown() || plan.ActiveVersion.IsUnknown() || plan.RetainVersions.IsUnknown() {
+		return
+	}
+
+	var existing []string
+	var state *CollectionRolloutResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &CollectionRolloutResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		resp.Diagnostics.Append(state.Collections.ElementsAs(ctx, &existing, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	target := rolloutCollectionName(plan.AliasName.ValueString(), plan.Version.ValueString())
+	active := rolloutCollectionName(plan.AliasName.ValueString(), plan.ActiveVersion.ValueString())
+	if active != target && !slices.Contains(existing, active) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("active_version"),
+			"Unknown version",
+			fmt.Sprintf("active_version %q must be version or a version that is still retained (%s).", plan.ActiveVersion.ValueString(), strings.Join(existing, ", ")),
+		)
+		return
+	}
+
+	// Existing versions keep the schema they were created with, so a change must come with a new version.
+	if state != nil && slices.Contains(existing, target) {
+		var planSchema, stateSchema types.Object
+		var planIndexes, stateIndexes types.List
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("schema"), &planSchema)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("schema"), &stateSchema)...)
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("indexes"), &planIndexes)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("indexes"), &stateIndexes)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		changed := map[string]bool{
+			"schema":  !planSchema.IsUnknown() && !planSchema.Equal(stateSchema),
+			"indexes": !planIndexes.IsUnknown() && !planIndexes.Equal(stateIndexes),
+		}
+		for _, attribute := range []string{"schema", "indexes"} {
+			if changed[attribute] {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(attribute),
+					"New version required",
+					fmt.Sprintf("Collection %s already exists. Set a new version to roll out a changed %s.", target, attribute),
+				)
+			}
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	collections, diags := types.ListValueFrom(ctx, types.StringType, rolloutCollections(target, active, existing, int(plan.RetainVersions.ValueInt64())))
+	resp.Diagnostics.Append(diags...)
+	plan.ActiveCollection = types.StringValue(active)
+	plan.Collections = collections
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *CollectionRolloutResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan CollectionRolloutResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCollectionRolloutTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.rollout(ctx, &plan, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = types.StringValue(BuildCollectionRolloutID(NormalizeConnectionID(plan.ConnectAddress.ValueString()), plan.DbName.ValueString(), plan.AliasName.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *CollectionRolloutResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state CollectionRolloutResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultCollectionRolloutTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.rollout(ctx, &plan, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// rollout brings the target version up, moves the alias to the active version and applies retention.
+// state is nil when the rollout is being created.
+func (r *CollectionRolloutResource) rollout(ctx context.Context, plan, state *CollectionRolloutResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	connectAddress := plan.ConnectAddress.ValueString()
+	dbName := plan.DbName.ValueString()
+	client, err := r.client.Collection(connectAddress, dbName)
+	if err != nil {
+		diags.AddError(
+			"Failed to get collection client",
+			fmt.Sprintf("ConnectAddress: %s, error: %s", connectAddress, err.Error()),
+		)
+		return diags
+	}
+
+	var existing, collections []string
+	if state != nil {
+		diags.Append(state.Collections.ElementsAs(ctx, &existing, false)...)
+	}
+	diags.Append(plan.Collections.ElementsAs(ctx, &collections, false)...)
+	if diags.HasError() {
+		return diags
+	}
+	target := rolloutCollectionName(plan.AliasName.ValueString(), plan.Version.ValueString())
+	active := plan.ActiveCollection.ValueString()
+
+	if !slices.Contains(existing, target) {
+		if err := r.createVersion(ctx, client, plan, target); err != nil {
+			diags.AddError(
+				"Failed to create collection version",
+				fmt.Sprintf("ConnectAddress: %s, DbName: %s, CollectionName: %s, error: %s", connectAddress, dbName, target, err.Error()),
+			)
+			return diags
+		}
+	}
+	for _, name := range []string{target, active} {
+		if err := ensureCollectionLoadState(ctx, client, dbName, name, CollectionLoaded); err != nil {
+			diags.AddError(
+				"Failed to load collection version",
+				fmt.Sprintf("ConnectAddress: %s, DbName: %s, CollectionName: %s, error: %s", connectAddress, dbName, name, err.Error()),
+			)
+			return diags
+		}
+	}
+
+	aliasName := plan.AliasName.ValueString()
+	switch {
+	case state == nil:
+		err = client.CreateAlias(&zilliz.CreateAliasParams{
+			DbName:         dbName,
+			AliasName:      aliasName,
+			CollectionName: active,
+		})
+	case state.ActiveCollection.ValueString() != active:
+		err = client.AlterAliases(&zilliz.AlterAliasesParams{
+			DbName:         dbName,
+			AliasName:      aliasName,
+			CollectionName: active,
+		})
+	}
+	if err != nil {
+		diags.AddError(
+			"Failed to point alias at collection version",
+			fmt.Sprintf("ConnectAddress: %s, DbName: %s, AliasName: %s, CollectionName: %s, error: %s", connectAddress, dbName, aliasName, active, err.Error()),
+		)
+		return diags
+	}
+
+	// Retention runs only once the alias has moved, so the collection serving traffic is never touched.
+	for _, name := range existing {
+		if slices.Contains(collections, name) {
+			continue
+		}
+		err := client.DropCollection(&zilliz.DropCollectionParams{
+			DbName:         dbName,
+			CollectionName: name,
+		})
+		if err != nil {
+			diags.AddError(
+				"Failed to drop old collection version",
+				fmt.Sprintf("ConnectAddress: %s, DbName: %s, CollectionName: %s, error: %s", connectAddress, dbName, name, err.Error()),
+			)
+			return diags
+		}
+	}
+	for _, name := range collections {
+		if name == target || name == active {
+			continue
+		}
+		if err := ensureCollectionLoadState(ctx, client, dbName, name, CollectionReleased); err != nil {
+			diags.AddError(
+				"Failed to release old collection version",
+				fmt.Sprintf("ConnectAddress: %s, DbName: %s, CollectionName: %s, error: %s", connectAddress, dbName, name, err.Error()),
+			)
+			return diags
+		}
+	}
+	return diags
+}
+
+// createVersion creates the collection for a version and waits until all of its indexes are built.
+// A collection left behind by an interrupted apply is reused rather than created again.
+func (r *CollectionRolloutResource) createVersion(ctx context.Context, client *zilliz.ClientCollection, plan *CollectionRolloutResourceModel, collectionName string) error {
+	dbName := plan.DbName.ValueString()
+	names, err := client.ListCollections(&zilliz.ListCollectionsParams{DbName: dbName})
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(names, collectionName) {
+		err = client.CreateCollection(&zilliz.CreateCollectionParams{
+			DbName:         dbName,
+			CollectionName: collectionName,
+			Schema: zilliz.CollectionSchema{
+				AutoID:              plan.Schema.AutoID.ValueBool(),
+				EnabledDynamicField: plan.Schema.EnabledDynamicField.ValueBool(),
+				Fields:              convertSchemaFields(plan.Schema.Fields),
+			},
+			Params: map[string]any{"consistencyLevel": "Bounded"},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	desc, err := client.DescribeCollection(&zilliz.DescribeCollectionParams{
+		DbName:         dbName,
+		CollectionName: collectionName,
+	})
+	if err != nil {
+		return err
+	}
+	for _, index := range plan.Indexes {
+		indexName := index.IndexName.ValueString()
+		if slices.ContainsFunc(desc.Indexes, func(i zilliz.CollectionIndex) bool { return i.IndexName == indexName }) {
+			continue
+		}
+		config := map[string]string{}
+		for k, v := range index.Params.Elements() {
+			if s, ok := v.(types.String); ok {
+				config[k] = s.ValueString()
+			}
+		}
+		config["index_type"] = index.IndexType.ValueString()
+		err = client.CreateIndex(&zilliz.CreateIndexParams{
+			DbName:         dbName,
+			CollectionName: collectionName,
+			IndexParams: []zilliz.IndexParams{
+				{
+					MetricType:  index.MetricType.ValueString(),
+					FieldName:   index.FieldName.ValueString(),
+					IndexName:   indexName,
+					IndexConfig: config,
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, index := range plan.Indexes {
+		if err := waitForIndexBuilt(ctx, client, dbName, collectionName, index.IndexName.ValueString()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// waitForIndexBuilt polls an index until it has finished building.
+func waitForIndexBuilt(ctx context.Context, client *zilliz.ClientCollection, dbName, collectionName, indexName string) error {
+	_, err := util.Poll(ctx, defaultCollectionRolloutTimeout, func() (*zilliz.IndexDescription, *util.Err) {
+		indexes, err := client.DescribeIndex(&zilliz.DescribeIndexParams{
+			DbName:         dbName,
+			CollectionName: collectionName,
+			IndexName:      indexName,
+		})
+		if err != nil {
+			return nil, &util.Err{Err: err, Halt: !util.IsNetworkError(err)}
+		}
+		for i := range indexes {
+			if indexes[i].IndexName != indexName {
+				continue
+			}
+			switch indexes[i].IndexState {
+			case zilliz.IndexStateFinished:
+				return &indexes[i], nil
+			case zilliz.IndexStateFailed:
+				return nil, &util.Err{Err: fmt.Errorf("index %s failed to build: %s", indexName, indexes[i].FailReason), Halt: true}
+			}
+			return nil, &util.Err{
+				Err:  fmt.Errorf("index %s not built yet. Current state: %s, pending rows: %d", indexName, indexes[i].IndexState, indexes[i].PendingRows),
+				Halt: false,
+			}
+		}
+		return nil, &util.Err{Err: fmt.Errorf("index %s not found", indexName), Halt: false}
+	})
+	return err
+}
+
+// describeRolloutAlias describes the alias, or returns nil when the database
+// has no such alias.
+func describeRolloutAlias(client *zilliz.ClientCollection, dbName, aliasName string) (*zilliz.AliasDescription, error) {
+	aliases, err := client.ListAliases(&zilliz.ListAliasesParams{DbName: dbName})
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(aliases, aliasName) {
+		return nil, nil
+	}
+	return client.DescribeAlias(&zilliz.DescribeAliasParams{DbName: dbName, AliasName: aliasName})
+}
+
+func (r *CollectionRolloutResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CollectionRolloutResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectAddress := data.ConnectAddress.ValueString()
+	client, err := r.client.Collection(connectAddress, data.DbName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get collection client",
+			fmt.Sprintf("ConnectAddress: %s, error: %s", connectAddress, err.Error()),
+		)
+		return
+	}
+
+	alias, err := describeRolloutAlias(client, data.DbName.ValueString(), data.AliasName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to describe alias",
+			fmt.Sprintf("ConnectAddress: %s, DbName: %s, AliasName: %s, error: %s", connectAddress, data.DbName.ValueString(), data.AliasName.ValueString(), err.Error()),
+		)
+		return
+	}
+	if alias == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	data.ActiveCollection = types.StringValue(alias.CollectionName)
+
+	// Versions dropped outside Terraform are forgotten so they are not released or dropped again.
+	names, err := client.ListCollections(&zilliz.ListCollectionsParams{DbName: data.DbName.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to list collections",
+			fmt.Sprintf("ConnectAddress: %s, DbName: %s, error: %s", connectAddress, data.DbName.ValueString(), err.Error()),
+		)
+		return
+	}
+	var collections []string
+	resp.Diagnostics.Append(data.Collections.ElementsAs(ctx, &collections, false)...)
+	collections = slices.DeleteFunc(collections, func(name string) bool { return !slices.Contains(names, name) })
+	var diags diag.Diagnostics
+	data.Collections, diags = types.ListValueFrom(ctx, types.StringType, collections)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CollectionRolloutResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CollectionRolloutResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectAddress := data.ConnectAddress.ValueString()
+	client, err := r.client.Collection(connectAddress, data.DbName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get collection client",
+			fmt.Sprintf("ConnectAddress: %s, error: %s", connectAddress, err.Error()),
+		)
+		return
+	}
+
+	// An alias dropped outside Terraform is gone already, the versions are still dropped.
+	aliases, err := client.ListAliases(&zilliz.ListAliasesParams{DbName: data.DbName.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to list aliases",
+			fmt.Sprintf("ConnectAddress: %s, DbName: %s, error: %s", connectAddress, data.DbName.ValueString(), err.Error()),
+		)
+		return
+	}
+	if slices.Contains(aliases, data.AliasName.ValueString()) {
+		err = client.DropAlias(&zilliz.DropAliasParams{
+			DbName:    data.DbName.ValueString(),
+			AliasName: data.AliasName.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to drop alias",
+				fmt.Sprintf("ConnectAddress: %s, DbName: %s, AliasName: %s, error: %s", connectAddress, data.DbName.ValueString(), data.AliasName.ValueString(), err.Error()),
+			)
+			return
+		}
+	}
+
+	var collections []string
+	resp.Diagnostics.Append(data.Collections.ElementsAs(ctx, &collections, false)...)
+	for _, name := range collections {
+		err = client.DropCollection(&zilliz.DropCollectionParams{
+			DbName:         data.DbName.ValueString(),
+			CollectionName: name,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to drop collection version",
+				fmt.Sprintf("ConnectAddress: %s, DbName: %s, CollectionName: %s, error: %s", connectAddress, data.DbName.ValueString(), name, err.Error()),
+			)
+			return
+		}
+	}
+}
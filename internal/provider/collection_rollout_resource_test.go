@@ -0,0 +1,103 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/zilliztech/terraform-provider-zillizcloud/internal/provider"
+)
+
+func testAccCollectionRolloutConfig(version, activeVersion string) string {
+	active := ""
+	if activeVersion != "" {
+		active = fmt.Sprintf("active_version  = %q", activeVersion)
+	}
+	return provider.ProviderConfig + fmt.Sprintf(`
+resource "zillizcloud_database" "test" {
+  connect_address = "https://in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534"
+  db_name         = "testdb"
+}
+resource "zillizcloud_collection_rollout" "test" {
+  connect_address = zillizcloud_database.test.connect_address
+  db_name         = zillizcloud_database.test.db_name
+  alias_name      = "testrollout"
+  version         = %q
+  %s
+  schema = {
+    fields = [
+      {
+        field_name = "id"
+        data_type  = "Int64"
+        is_primary = true
+      },
+      {
+        field_name = "vector"
+        data_type  = "FloatVector"
+        element_type_params = {
+          dim = "128"
+        }
+      }
+    ]
+  }
+  indexes = [
+    {
+      field_name  = "vector"
+      index_name  = "vector_idx"
+      index_type  = "AUTOINDEX"
+      metric_type = "L2"
+    }
+  ]
+}
+`, version, active)
+}
+
+func TestAccCollectionRolloutResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Step 1: First version behind the alias
+			{
+				Config: testAccCollectionRolloutConfig("v1", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zillizcloud_collection_rollout.test", "active_collection", "testrollout_v1"),
+					resource.TestCheckResourceAttr("zillizcloud_collection_rollout.test", "collections.#", "1"),
+					resource.TestCheckResourceAttrSet("zillizcloud_collection_rollout.test", "id"),
+				),
+			},
+			// Step 2: Create v2 while traffic stays on v1
+			{
+				Config: testAccCollectionRolloutConfig("v2", "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zillizcloud_collection_rollout.test", "active_collection", "testrollout_v1"),
+					resource.TestCheckResourceAttr("zillizcloud_collection_rollout.test", "collections.0", "testrollout_v2"),
+					resource.TestCheckResourceAttr("zillizcloud_collection_rollout.test", "collections.1", "testrollout_v1"),
+				),
+			},
+			// Step 3: Cut over to v2 in place, keeping v1 for rollback
+			{
+				Config: testAccCollectionRolloutConfig("v2", ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("zillizcloud_collection_rollout.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zillizcloud_collection_rollout.test", "active_collection", "testrollout_v2"),
+					resource.TestCheckResourceAttr("zillizcloud_collection_rollout.test", "collections.#", "2"),
+				),
+			},
+			// Step 4: v3 drops v1 once the alias has moved
+			{
+				Config: testAccCollectionRolloutConfig("v3", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zillizcloud_collection_rollout.test", "active_collection", "testrollout_v3"),
+					resource.TestCheckResourceAttr("zillizcloud_collection_rollout.test", "collections.0", "testrollout_v3"),
+					resource.TestCheckResourceAttr("zillizcloud_collection_rollout.test", "collections.1", "testrollout_v2"),
+					resource.TestCheckResourceAttr("zillizcloud_collection_rollout.test", "collections.#", "2"),
+				),
+			},
+		},
+	})
+}
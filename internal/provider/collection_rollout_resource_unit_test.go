@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+)
+
+func TestRolloutCollections(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		active   string
+		existing []string
+		retain   int
+		want     []string
+	}{
+		{
+			name:   "first version",
+			target: "live_v1", active: "live_v1",
+			want: []string{"live_v1"},
+		},
+		{
+			name:   "cutover keeps one previous version",
+			target: "live_v3", active: "live_v3",
+			existing: []string{"live_v2", "live_v1"},
+			retain:   1,
+			want:     []string{"live_v3", "live_v2"},
+		},
+		{
+			name:   "no retention",
+			target: "live_v2", active: "live_v2",
+			existing: []string{"live_v1"},
+			want:     []string{"live_v2"},
+		},
+		{
+			name:   "backfill keeps the active version and its predecessor",
+			target: "live_v3", active: "live_v2",
+			existing: []string{"live_v2", "live_v1"},
+			retain:   1,
+			want:     []string{"live_v3", "live_v2", "live_v1"},
+		},
+		{
+			name:   "rollback to a retained version",
+			target: "live_v1", active: "live_v1",
+			existing: []string{"live_v2", "live_v1"},
+			retain:   1,
+			want:     []string{"live_v1", "live_v2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := rolloutCollections(tt.target, tt.active, tt.existing, tt.retain)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("rolloutCollections() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWaitForIndexBuilt(t *testing.T) {
+	states := []string{"InProgress", zilliz.IndexStateFinished}
+	var calls int
+	client := newTestCollectionClient(t, func(call int, req *http.Request, body []byte) (*http.Response, error) {
+		if !strings.HasSuffix(req.URL.Path, "/v2/vectordb/indexes/describe") {
+			t.Fatalf("unexpected request %s", req.URL.Path)
+		}
+		state := states[min(calls, len(states)-1)]
+		calls++
+		return volumeJSONResponse(t, http.StatusOK, map[string]any{
+			"code": 0,
+			"data": []zilliz.IndexDescription{{IndexName: "vector_idx", IndexState: state}},
+		}), nil
+	})
+
+	if err := waitForIndexBuilt(context.Background(), client, "testdb", "live_v2", "vector_idx"); err != nil {
+		t.Fatalf("waitForIndexBuilt: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("describe calls = %d, want 2", calls)
+	}
+}
+
+func TestWaitForIndexBuiltFailed(t *testing.T) {
+	client := newTestCollectionClient(t, func(call int, req *http.Request, body []byte) (*http.Response, error) {
+		return volumeJSONResponse(t, http.StatusOK, map[string]any{
+			"code": 0,
+			"data": []zilliz.IndexDescription{{IndexName: "vector_idx", IndexState: zilliz.IndexStateFailed, FailReason: "dim mismatch"}},
+		}), nil
+	})
+
+	err := waitForIndexBuilt(context.Background(), client, "testdb", "live_v2", "vector_idx")
+	if err == nil || !strings.Contains(err.Error(), "dim mismatch") {
+		t.Fatalf("waitForIndexBuilt error = %v, want build failure", err)
+	}
+}
+
+func TestCollectionRolloutResourceDeleteDropsVersionsWhenAliasIsGone(t *testing.T) {
+	ctx := context.Background()
+	var paths []string
+	var dropped []string
+	client, err := zilliz.NewClient(
+		zilliz.WithApiKey("test-key"),
+		zilliz.WithHTTPClient(&volumeResourceMockHTTPClient{t: t, do: func(call int, req *http.Request, body []byte) (*http.Response, error) {
+			paths = append(paths, req.URL.Path)
+			switch req.URL.Path {
+			case "/v2/vectordb/aliases/list":
+				return volumeJSONResponse(t, http.StatusOK, map[string]any{"code": 0, "data": []string{}}), nil
+			case "/v2/vectordb/collections/drop":
+				var params zilliz.DropCollectionParams
+				if err := json.Unmarshal(body, &params); err != nil {
+					t.Fatalf("unmarshal: %v", err)
+				}
+				dropped = append(dropped, params.CollectionName)
+				return volumeJSONResponse(t, http.StatusOK, map[string]any{"code": 0, "data": map[string]any{}}), nil
+			}
+			t.Fatalf("unexpected request %s", req.URL.Path)
+			return nil, nil
+		}}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	r := &CollectionRolloutResource{client: client}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	state.SetAttribute(ctx, path.Root("connect_address"), "https://in01-test.zillizcloud.com:19530")
+	state.SetAttribute(ctx, path.Root("db_name"), "testdb")
+	state.SetAttribute(ctx, path.Root("alias_name"), "live")
+	state.SetAttribute(ctx, path.Root("collections"), []string{"live_v2", "live_v1"})
+
+	var resp fwresource.DeleteResponse
+	r.Delete(ctx, fwresource.DeleteRequest{State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Delete: %v", resp.Diagnostics)
+	}
+	if slices.Contains(paths, "/v2/vectordb/aliases/drop") {
+		t.Errorf("requests = %v, want no alias drop for a missing alias", paths)
+	}
+	if !slices.Equal(dropped, []string{"live_v2", "live_v1"}) {
+		t.Errorf("dropped collections = %v, want both versions", dropped)
+	}
+}
@@ -214,3 +214,65 @@ func TestConvertSchemaFieldModel(t *testing.T) {
 		})
 	}
 }
+
+func TestCanonicalCollectionDataType(t *testing.T) {
+	tests := map[string]string{
+		"Int64":                    "Int64",
+		"INT64":                    "Int64",
+		"varchar":                  "VarChar",
+		"FLOAT_VECTOR":             "FloatVector",
+		"DataType.BFLOAT16_VECTOR": "BFloat16Vector",
+		"SparseFloatVector":        "SparseFloatVector",
+		"json":                     "JSON",
+		"Geometry":                 "Geometry",
+		"":                         "",
+	}
+	for input, expected := range tests {
+		if got := canonicalCollectionDataType(input); got != expected {
+			t.Errorf("canonicalCollectionDataType(%q) = %q, want %q", input, got, expected)
+		}
+	}
+}
+
+func TestConvertSchemaFieldModelFromUppercaseTypes(t *testing.T) {
+	result := convertSchemaFieldModel(client.CollectionField{
+		Name:            "tags",
+		Type:            "ARRAY",
+		ElementDataType: "VARCHAR",
+		Params:          []client.FieldParam{{Key: "max_length", Value: "64"}},
+	})
+	if result.DataType.ValueString() != "Array" || result.ElementDataType.ValueString() != "VarChar" {
+		t.Errorf("got DataType %q, ElementDataType %q", result.DataType.ValueString(), result.ElementDataType.ValueString())
+	}
+
+	result = convertSchemaFieldModel(client.CollectionField{Name: "id", Type: "Int64", PrimaryKey: true})
+	if !result.ElementDataType.IsNull() {
+		t.Errorf("expected null ElementDataType for a scalar field, got %q", result.ElementDataType.ValueString())
+	}
+}
+
+func TestPreserveConfiguredDataTypes(t *testing.T) {
+	prior := &CollectionSchemaModel{
+		Fields: []CollectionSchemaFieldModel{
+			{FieldName: types.StringValue("id"), DataType: types.StringValue("INT64"), ElementDataType: types.StringNull()},
+			{FieldName: types.StringValue("tags"), DataType: types.StringValue("array"), ElementDataType: types.StringValue("varchar")},
+			{FieldName: types.StringValue("score"), DataType: types.StringValue("Float"), ElementDataType: types.StringNull()},
+		},
+	}
+	fields := []CollectionSchemaFieldModel{
+		convertSchemaFieldModel(client.CollectionField{Name: "id", Type: "Int64"}),
+		convertSchemaFieldModel(client.CollectionField{Name: "tags", Type: "Array", ElementDataType: "VarChar"}),
+		convertSchemaFieldModel(client.CollectionField{Name: "score", Type: "Double"}),
+	}
+	preserveConfiguredDataTypes(prior, fields)
+
+	if fields[0].DataType.ValueString() != "INT64" {
+		t.Errorf("id: expected configured spelling INT64, got %q", fields[0].DataType.ValueString())
+	}
+	if fields[1].DataType.ValueString() != "array" || fields[1].ElementDataType.ValueString() != "varchar" {
+		t.Errorf("tags: got %q / %q", fields[1].DataType.ValueString(), fields[1].ElementDataType.ValueString())
+	}
+	if fields[2].DataType.ValueString() != "Double" {
+		t.Errorf("score: a real type change must be reported, got %q", fields[2].DataType.ValueString())
+	}
+}
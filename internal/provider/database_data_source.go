@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+)
+
+var _ datasource.DataSource = &DatabaseDataSource{}
+
+func NewDatabaseDataSource() datasource.DataSource {
+	return &DatabaseDataSource{}
+}
+
+type DatabaseDataSource struct {
+	client *zilliz.Client
+}
+
+type DatabaseDataSourceModel struct {
+	ConnectAddress   types.String            `tfsdk:"connect_address"`
+	DbName           types.String            `tfsdk:"db_name"`
+	Properties       map[string]types.String `tfsdk:"properties"`
+	ResourceGroups   []types.String          `tfsdk:"resource_groups"`
+	ReplicaNumber    types.Int64             `tfsdk:"replica_number"`
+	MaxCollections   types.Int64             `tfsdk:"max_collections"`
+	ForceDenyWriting types.Bool              `tfsdk:"force_deny_writing"`
+	ForceDenyReading types.Bool              `tfsdk:"force_deny_reading"`
+	MmapEnabled      types.Bool              `tfsdk:"mmap_enabled"`
+}
+
+func (d *DatabaseDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database"
+}
+
+func (d *DatabaseDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Read an existing database by connect_address and db_name, including its properties. Properties that are not set on the database are null.",
+		Attributes: map[string]schema.Attribute{
+			"connect_address": schema.StringAttribute{
+				MarkdownDescription: `The connection address of the target Zilliz Cloud cluster.
+You can obtain this value from the output of the ` + "`zillizcloud_cluster`" + ` resource, for example:
+` + "`zillizcloud_cluster.example.connect_address`" + `
+
+**Example:**
+` + "`https://in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534`" + `
+
+> **Note:** The address must include the protocol (e.g., ` + "`https://`" + `).`,
+				Required: true,
+			},
+			"db_name": schema.StringAttribute{
+				MarkdownDescription: "Database name",
+				Required:            true,
+			},
+			"properties": schema.MapAttribute{
+				MarkdownDescription: "All properties of the database, as reported by the cluster",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"resource_groups": schema.ListAttribute{
+				MarkdownDescription: "Resource groups that load the database's collections",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"replica_number": schema.Int64Attribute{
+				MarkdownDescription: "Number of replicas the database's collections are loaded with",
+				Computed:            true,
+			},
+			"max_collections": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of collections allowed in the database",
+				Computed:            true,
+			},
+			"force_deny_writing": schema.BoolAttribute{
+				MarkdownDescription: "Whether write operations to the database are denied",
+				Computed:            true,
+			},
+			"force_deny_reading": schema.BoolAttribute{
+				MarkdownDescription: "Whether read operations on the database are denied",
+				Computed:            true,
+			},
+			"mmap_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether memory mapping is enabled for the database's collections",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *DatabaseDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*zilliz.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zilliz.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *DatabaseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state DatabaseDataSourceModel
+
+	// Parse config input
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientCluster, err := d.client.Cluster(state.ConnectAddress.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to create cluster client for connect_address %q: %s", state.ConnectAddress.ValueString(), err))
+		return
+	}
+	db, err := clientCluster.DescribeDatabase(zilliz.DescribeDatabaseParams{
+		DbName: state.DbName.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to describe database %q for connect_address %q: %s", state.DbName.ValueString(), state.ConnectAddress.ValueString(), err))
+		return
+	}
+
+	apiProps := databasePropertiesFromAPI(db.Properties)
+	state.Properties = make(map[string]types.String, len(apiProps))
+	for k, v := range apiProps {
+		state.Properties[k] = types.StringValue(v)
+	}
+
+	// The typed attributes are read the same way as on import of zillizcloud_database
+	typed := DatabaseResourceModel{Properties: types.MapNull(types.StringType)}
+	if err := typed.populateProperties(apiProps, true); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to convert properties of database %q: %s", state.DbName.ValueString(), err))
+		return
+	}
+	state.ResourceGroups = typed.ResourceGroups
+	state.ReplicaNumber = typed.ReplicaNumber
+	state.MaxCollections = typed.MaxCollections
+	state.ForceDenyWriting = typed.ForceDenyWriting
+	state.ForceDenyReading = typed.ForceDenyReading
+	state.MmapEnabled = typed.MmapEnabled
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
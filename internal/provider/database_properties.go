@@ -0,0 +1,244 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zilliztech/terraform-provider-zillizcloud/internal/provider/utils"
+)
+
+// Database properties that are exposed as typed attributes.
+const (
+	databaseReplicaNumberProperty    = "database.replica.number"
+	databaseResourceGroupsProperty   = "database.resource_groups"
+	databaseMaxCollectionsProperty   = "database.max.collections"
+	databaseForceDenyWritingProperty = "database.force.deny.writing"
+	databaseForceDenyReadingProperty = "database.force.deny.reading"
+	databaseMmapEnabledProperty      = "mmap.enabled"
+)
+
+// databaseTypedProperties maps each typed property to its attribute.
+var databaseTypedProperties = map[string]string{
+	databaseReplicaNumberProperty:    "replica_number",
+	databaseResourceGroupsProperty:   "resource_groups",
+	databaseMaxCollectionsProperty:   "max_collections",
+	databaseForceDenyWritingProperty: "force_deny_writing",
+	databaseForceDenyReadingProperty: "force_deny_reading",
+	databaseMmapEnabledProperty:      "mmap_enabled",
+}
+
+func (r *DatabaseResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DatabaseResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Properties.IsNull() || data.Properties.IsUnknown() {
+		return
+	}
+
+	props := data.Properties.Elements()
+	for key, attr := range databaseTypedProperties {
+		if _, ok := props[key]; ok && data.typedPropertySet(key) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("properties"),
+				"Conflicting database property",
+				fmt.Sprintf("%q cannot be set in properties together with %s.", key, attr),
+			)
+		}
+	}
+}
+
+// databasePropertiesFromAPI converts the key/value pairs returned by the API
+// into normalized strings.
+func databasePropertiesFromAPI(props []map[string]any) map[string]string {
+	result := make(map[string]string, len(props))
+	for _, kv := range props {
+		k, ok := kv["key"].(string)
+		if !ok || kv["value"] == nil {
+			continue
+		}
+		result[k] = normalizeDatabasePropertyValue(kv["value"])
+	}
+	return result
+}
+
+// normalizeDatabasePropertyValue formats a property value the same way no
+// matter whether it was sent or returned as a string, bool or number, so
+// that "3", 3 and 3.0 compare equal.
+func normalizeDatabasePropertyValue(v any) string {
+	s := strings.TrimSpace(fmt.Sprintf("%v", v))
+	if strings.EqualFold(s, "true") || strings.EqualFold(s, "false") {
+		return strings.ToLower(s)
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil && f == float64(int64(f)) {
+		return strconv.FormatInt(int64(f), 10)
+	}
+	return s
+}
+
+// removedDatabaseProperties returns the sorted keys that are managed in the
+// state but no longer in the plan.
+func removedDatabaseProperties(stateProps, planProps map[string]any) []string {
+	var removed []string
+	for k := range stateProps {
+		if _, ok := planProps[k]; !ok {
+			removed = append(removed, k)
+		}
+	}
+	sort.Strings(removed)
+	return removed
+}
+
+func (m *DatabaseResourceModel) typedPropertySet(key string) bool {
+	switch key {
+	case databaseReplicaNumberProperty:
+		return !m.ReplicaNumber.IsNull()
+	case databaseResourceGroupsProperty:
+		return m.ResourceGroups != nil
+	case databaseMaxCollectionsProperty:
+		return !m.MaxCollections.IsNull()
+	case databaseForceDenyWritingProperty:
+		return !m.ForceDenyWriting.IsNull()
+	case databaseForceDenyReadingProperty:
+		return !m.ForceDenyReading.IsNull()
+	case databaseMmapEnabledProperty:
+		return !m.MmapEnabled.IsNull()
+	}
+	return false
+}
+
+// apiProperties merges the properties map with the typed attributes into the
+// properties sent to the API.
+func (m *DatabaseResourceModel) apiProperties() (map[string]any, error) {
+	props, err := utils.ConvertPropertiesToMap(m.Properties)
+	if err != nil {
+		return nil, err
+	}
+	if props == nil {
+		props = make(map[string]any)
+	}
+	if m.ResourceGroups != nil {
+		groups := make([]string, 0, len(m.ResourceGroups))
+		for _, rg := range m.ResourceGroups {
+			groups = append(groups, rg.ValueString())
+		}
+		props[databaseResourceGroupsProperty] = strings.Join(groups, ",")
+	}
+	if !m.ReplicaNumber.IsNull() {
+		props[databaseReplicaNumberProperty] = m.ReplicaNumber.ValueInt64()
+	}
+	if !m.MaxCollections.IsNull() {
+		props[databaseMaxCollectionsProperty] = m.MaxCollections.ValueInt64()
+	}
+	if !m.ForceDenyWriting.IsNull() {
+		props[databaseForceDenyWritingProperty] = m.ForceDenyWriting.ValueBool()
+	}
+	if !m.ForceDenyReading.IsNull() {
+		props[databaseForceDenyReadingProperty] = m.ForceDenyReading.ValueBool()
+	}
+	if !m.MmapEnabled.IsNull() {
+		props[databaseMmapEnabledProperty] = m.MmapEnabled.ValueBool()
+	}
+	if len(props) == 0 {
+		return nil, nil
+	}
+	return props, nil
+}
+
+// populateProperties refreshes properties and the typed attributes from the
+// properties reported by the API. Only managed properties, i.e. the keys in
+// the properties map and the typed attributes that are set, are refreshed,
+// unless all is true, which adopts every typed property (used on import).
+func (m *DatabaseResourceModel) populateProperties(apiProps map[string]string, all bool) error {
+	if all || m.ResourceGroups != nil {
+		m.ResourceGroups = nil
+		if v, ok := apiProps[databaseResourceGroupsProperty]; ok {
+			m.ResourceGroups = []types.String{}
+			for _, rg := range strings.Split(v, ",") {
+				if rg = strings.TrimSpace(rg); rg != "" {
+					m.ResourceGroups = append(m.ResourceGroups, types.StringValue(rg))
+				}
+			}
+		}
+	}
+
+	var err error
+	if all || !m.ReplicaNumber.IsNull() {
+		if m.ReplicaNumber, err = databaseInt64Property(apiProps, databaseReplicaNumberProperty); err != nil {
+			return err
+		}
+	}
+	if all || !m.MaxCollections.IsNull() {
+		if m.MaxCollections, err = databaseInt64Property(apiProps, databaseMaxCollectionsProperty); err != nil {
+			return err
+		}
+	}
+	if all || !m.ForceDenyWriting.IsNull() {
+		if m.ForceDenyWriting, err = databaseBoolProperty(apiProps, databaseForceDenyWritingProperty); err != nil {
+			return err
+		}
+	}
+	if all || !m.ForceDenyReading.IsNull() {
+		if m.ForceDenyReading, err = databaseBoolProperty(apiProps, databaseForceDenyReadingProperty); err != nil {
+			return err
+		}
+	}
+	if all || !m.MmapEnabled.IsNull() {
+		if m.MmapEnabled, err = databaseBoolProperty(apiProps, databaseMmapEnabledProperty); err != nil {
+			return err
+		}
+	}
+
+	if m.Properties.IsNull() || m.Properties.IsUnknown() {
+		m.Properties = types.MapNull(types.StringType)
+		return nil
+	}
+	// Keep the configured spelling of values that only differ in format,
+	// e.g. "3.0" vs "3", and drop keys the server no longer reports.
+	managed := make(map[string]types.String)
+	for k, v := range m.Properties.Elements() {
+		current, ok := apiProps[k]
+		if !ok {
+			continue
+		}
+		if s, isString := v.(types.String); isString && normalizeDatabasePropertyValue(s.ValueString()) == current {
+			managed[k] = s
+		} else {
+			managed[k] = types.StringValue(current)
+		}
+	}
+	propsMap, diags := types.MapValueFrom(context.Background(), types.StringType, managed)
+	if diags.HasError() {
+		return fmt.Errorf("failed to create map: %v", diags)
+	}
+	m.Properties = propsMap
+	return nil
+}
+
+func databaseInt64Property(apiProps map[string]string, key string) (types.Int64, error) {
+	v, ok := apiProps[key]
+	if !ok {
+		return types.Int64Null(), nil
+	}
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return types.Int64Null(), fmt.Errorf("invalid %s %q: %w", key, v, err)
+	}
+	return types.Int64Value(n), nil
+}
+
+func databaseBoolProperty(apiProps map[string]string, key string) (types.Bool, error) {
+	v, ok := apiProps[key]
+	if !ok {
+		return types.BoolNull(), nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return types.BoolNull(), fmt.Errorf("invalid %s %q: %w", key, v, err)
+	}
+	return types.BoolValue(b), nil
+}
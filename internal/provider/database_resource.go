@@ -5,18 +5,20 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
-	"github.com/zilliztech/terraform-provider-zillizcloud/internal/provider/utils"
 )
 
 var _ resource.Resource = &DatabaseResource{}
 var _ resource.ResourceWithConfigure = &DatabaseResource{}
 var _ resource.ResourceWithImportState = &DatabaseResource{}
+var _ resource.ResourceWithValidateConfig = &DatabaseResource{}
 
 func NewDatabaseResource() resource.Resource {
 	return &DatabaseResource{}
@@ -31,6 +33,13 @@ type DatabaseResourceModel struct {
 	ConnectAddress types.String `tfsdk:"connect_address"`
 	DbName         types.String `tfsdk:"db_name"`
 	Properties     types.Map    `tfsdk:"properties"`
+
+	ResourceGroups   []types.String `tfsdk:"resource_groups"`
+	ReplicaNumber    types.Int64    `tfsdk:"replica_number"`
+	MaxCollections   types.Int64    `tfsdk:"max_collections"`
+	ForceDenyWriting types.Bool     `tfsdk:"force_deny_writing"`
+	ForceDenyReading types.Bool     `tfsdk:"force_deny_reading"`
+	MmapEnabled      types.Bool     `tfsdk:"mmap_enabled"`
 }
 
 func (r *DatabaseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -87,16 +96,49 @@ You can obtain this value from the output of the ` + "`zillizcloud_cluster`" + `
 **Example:**
 
 ` + "`" + `{
-  "database.replica.number": "3",
-  "database.max.collections": "1000",
-  "database.force.deny.writing": "true"
+  "database.diskQuota.mb": "1024"
 }` + "`" + `
 
 > All values should be provided as strings and will be converted to appropriate types internally. Support properties can be found [here](https://docs.zilliz.com/reference/restful/create-database-v2)
 
 **Reference:** https://github.com/milvus-io/milvus/blob/v2.5.12/pkg/common/common.go#L186
+
+> **Note:** Properties with a typed attribute, such as ` + "`" + databaseReplicaNumberProperty + "`" + `, should be set through that attribute.
+Only the keys listed here are tracked; properties added by the server are ignored.
 `,
 			},
+			"resource_groups": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: `The resource groups that load the replicas of the database's collections.
+Use it with ` + "`zillizcloud_resource_group`" + ` to pin a tenant's database to dedicated query nodes.`,
+			},
+			"replica_number": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: `The number of replicas the database's collections are loaded with. The replicas are spread over ` + "`resource_groups`" + `.`,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_collections": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The maximum number of collections allowed in the database.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"force_deny_writing": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether write operations to the database are denied.",
+			},
+			"force_deny_reading": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether read operations on the database are denied.",
+			},
+			"mmap_enabled": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether memory mapping is enabled for the collections in the database.",
+			},
 		},
 	}
 }
@@ -133,18 +175,14 @@ func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	// Parse properties only if they are provided
-	var props map[string]any
-	if !data.Properties.IsNull() {
-		var err error
-		props, err = utils.ConvertPropertiesToMap(data.Properties)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Failed to convert properties",
-				err.Error(),
-			)
-			return
-		}
+	// Merge properties with the typed attributes
+	props, err := data.apiProperties()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to convert properties",
+			err.Error(),
+		)
+		return
 	}
 
 	_, err = client.CreateDatabase(zilliz.CreateDatabaseParams{
@@ -193,25 +231,14 @@ func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	// Convert API properties to Map format
-	apiProps := make(map[string]any)
-	for _, kv := range db.Properties {
-		if k, ok := kv["key"].(string); ok {
-			if v, ok := kv["value"].(string); ok {
-				apiProps[k] = v
-			}
-		}
-	}
-
-	propsMap, err := utils.ConvertMapToProperties(apiProps)
-	if err != nil {
+	// Only managed properties are refreshed, so server defaults do not show up as drift
+	if err := state.populateProperties(databasePropertiesFromAPI(db.Properties), false); err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to convert properties",
 			err.Error(),
 		)
 		return
 	}
-	state.Properties = propsMap
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...) // Keep state
 }
@@ -258,6 +285,20 @@ func (r *DatabaseResource) ImportState(ctx context.Context, req resource.ImportS
 
 	connectAddress = "https://" + connectAddress
 
+	client, err := r.client.Cluster(connectAddress)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get cluster client (import)", fmt.Sprintf("ConnectAddress: %s, error: %s", connectAddress, err))
+		return
+	}
+	db, err := client.DescribeDatabase(zilliz.DescribeDatabaseParams{DbName: dbName})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to import database: database does not exist or cannot be retrieved",
+			fmt.Sprintf("ConnectAddress: %s, DbName: %s, error: %s", connectAddress, dbName, err.Error()),
+		)
+		return
+	}
+
 	// Set connect_address as is
 	state := DatabaseResourceModel{
 		Id:             types.StringValue(req.ID),
@@ -265,6 +306,14 @@ func (r *DatabaseResource) ImportState(ctx context.Context, req resource.ImportS
 		DbName:         types.StringValue(dbName),
 		Properties:     types.MapNull(types.StringType),
 	}
+	// Adopt every typed property the database has; untyped ones stay unmanaged
+	if err := state.populateProperties(databasePropertiesFromAPI(db.Properties), true); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to convert properties",
+			err.Error(),
+		)
+		return
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...) // Save state
 }
 
@@ -317,7 +366,7 @@ func (r *DatabaseResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	// Parse the desired properties from the plan
-	planProps, err := utils.ConvertPropertiesToMap(plan.Properties)
+	planProps, err := plan.apiProperties()
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to convert properties",
@@ -326,34 +375,28 @@ func (r *DatabaseResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	// Extract the current properties from the API response (array of key-value maps)
-	dbProps := map[string]string{}
-	for _, kv := range db.Properties {
-		k, vok := kv["key"].(string)
-		v, vok2 := kv["value"].(string)
-		if vok && vok2 {
-			dbProps[k] = v
+	// Compare the planned properties with the current ones. Properties that are
+	// not managed are left alone.
+	dbProps := databasePropertiesFromAPI(db.Properties)
+	needUpdate := false
+	for k, v := range planProps {
+		if current, ok := dbProps[k]; !ok || current != normalizeDatabasePropertyValue(v) {
+			needUpdate = true
+			break
 		}
 	}
 
-	// Compare plan and current properties. If any difference, update is needed.
-	needUpdate := false
-	if len(planProps) != len(dbProps) {
-		needUpdate = true
-	} else {
-		for k, v := range planProps {
-			if dbProps[k] != fmt.Sprintf("%v", v) {
-				needUpdate = true
-				break
-			}
-		}
-		for k, v := range dbProps {
-			if fmt.Sprintf("%v", planProps[k]) != v {
-				needUpdate = true
-				break
-			}
-		}
+	// Properties that were managed before but are no longer configured are
+	// dropped, so the server falls back to its defaults.
+	stateProps, err := state.apiProperties()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to convert properties",
+			err.Error(),
+		)
+		return
 	}
+	removed := removedDatabaseProperties(stateProps, planProps)
 
 	if needUpdate {
 		propsAny := make(map[string]any, len(planProps))
@@ -373,6 +416,20 @@ func (r *DatabaseResource) Update(ctx context.Context, req resource.UpdateReques
 		}
 	}
 
+	if len(removed) > 0 {
+		err := client.DropDatabaseProperties(zilliz.DropDatabasePropertiesParams{
+			DbName:       dbName,
+			PropertyKeys: removed,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to drop database properties",
+				fmt.Sprintf("Could not drop properties %v for database '%s' on connect_address '%s': %s", removed, dbName, connectAddress, err.Error()),
+			)
+			return
+		}
+	}
+
 	// Refresh state after update
 	plan.Id = types.StringValue(BuildDatabaseID(NormalizeConnectionID(connectAddress), dbName))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...) // Update state
@@ -102,3 +102,43 @@ resource "zillizcloud_database" "test" {
 		},
 	})
 }
+
+func TestAccDatabaseResourceTypedProperties(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: provider.ProviderConfig + `
+resource "zillizcloud_database" "test" {
+  connect_address    = "https://in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534"
+  db_name            = "typeddb"
+  replica_number     = 1
+  max_collections    = 10
+  force_deny_writing = false
+  force_deny_reading = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zillizcloud_database.test", "replica_number", "1"),
+					resource.TestCheckResourceAttr("zillizcloud_database.test", "max_collections", "10"),
+					resource.TestCheckResourceAttr("zillizcloud_database.test", "force_deny_writing", "false"),
+					resource.TestCheckNoResourceAttr("zillizcloud_database.test", "properties"),
+				),
+			},
+			// Re-applying the same config must not show a diff
+			{
+				Config: provider.ProviderConfig + `
+resource "zillizcloud_database" "test" {
+  connect_address    = "https://in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534"
+  db_name            = "typeddb"
+  replica_number     = 1
+  max_collections    = 10
+  force_deny_writing = false
+  force_deny_reading = false
+}
+`,
+				PlanOnly: true,
+			},
+		},
+	})
+}
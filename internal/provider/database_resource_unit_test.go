@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+)
+
+func TestDatabaseResourceTypedProperties(t *testing.T) {
+	model := DatabaseResourceModel{
+		Properties:       types.MapNull(types.StringType),
+		ResourceGroups:   []types.String{types.StringValue("rg_a"), types.StringValue("rg_b")},
+		ReplicaNumber:    types.Int64Value(2),
+		ForceDenyWriting: types.BoolValue(false),
+	}
+	props, err := model.apiProperties()
+	if err != nil {
+		t.Fatalf("apiProperties: %v", err)
+	}
+	if props[databaseResourceGroupsProperty] != "rg_a,rg_b" || props[databaseReplicaNumberProperty] != int64(2) || props[databaseForceDenyWritingProperty] != false {
+		t.Fatalf("props = %v", props)
+	}
+	if len(props) != 3 {
+		t.Errorf("unset typed attributes should not be sent: %v", props)
+	}
+}
+
+func TestDatabaseResourceReadReportsManagedDriftOnly(t *testing.T) {
+	apiProps := databasePropertiesFromAPI([]map[string]any{
+		{"key": databaseReplicaNumberProperty, "value": float64(3)},
+		{"key": databaseForceDenyWritingProperty, "value": "True"},
+		{"key": databaseResourceGroupsProperty, "value": "rg_a,rg_b"},
+		{"key": databaseMaxCollectionsProperty, "value": "64"},
+		{"key": "database.diskQuota.mb", "value": "1024"},
+		{"key": "database.force.deny.reading", "value": "false"},
+	})
+
+	model := DatabaseResourceModel{
+		Properties: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"database.diskQuota.mb": types.StringValue("1024.0"),
+			"database.removed":      types.StringValue("1"),
+		}),
+		ReplicaNumber:    types.Int64Value(1),
+		ForceDenyWriting: types.BoolValue(true),
+	}
+	if err := model.populateProperties(apiProps, false); err != nil {
+		t.Fatalf("populateProperties: %v", err)
+	}
+
+	if model.ReplicaNumber.ValueInt64() != 3 {
+		t.Errorf("replica_number = %v, want drift to 3", model.ReplicaNumber)
+	}
+	if !model.ForceDenyWriting.ValueBool() {
+		t.Errorf("force_deny_writing = %v, want true", model.ForceDenyWriting)
+	}
+	if model.ResourceGroups != nil || !model.MaxCollections.IsNull() || !model.ForceDenyReading.IsNull() {
+		t.Errorf("unmanaged typed properties should stay null: %v, %v, %v", model.ResourceGroups, model.MaxCollections, model.ForceDenyReading)
+	}
+	want := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"database.diskQuota.mb": types.StringValue("1024.0"),
+	})
+	if !model.Properties.Equal(want) {
+		t.Errorf("properties = %v, want %v", model.Properties, want)
+	}
+}
+
+func TestDatabaseResourceImportAdoptsTypedProperties(t *testing.T) {
+	apiProps := databasePropertiesFromAPI([]map[string]any{
+		{"key": databaseResourceGroupsProperty, "value": "rg_a, rg_b"},
+		{"key": databaseMmapEnabledProperty, "value": "true"},
+		{"key": "database.diskQuota.mb", "value": "1024"},
+	})
+
+	model := DatabaseResourceModel{Properties: types.MapNull(types.StringType)}
+	if err := model.populateProperties(apiProps, true); err != nil {
+		t.Fatalf("populateProperties: %v", err)
+	}
+	if len(model.ResourceGroups) != 2 || model.ResourceGroups[1].ValueString() != "rg_b" {
+		t.Errorf("resource_groups = %v", model.ResourceGroups)
+	}
+	if !model.MmapEnabled.ValueBool() || !model.ReplicaNumber.IsNull() {
+		t.Errorf("mmap_enabled = %v, replica_number = %v", model.MmapEnabled, model.ReplicaNumber)
+	}
+	if !model.Properties.IsNull() {
+		t.Errorf("properties = %v, want null", model.Properties)
+	}
+}
+
+func TestDatabaseResourceUpdateDropsRemovedProperties(t *testing.T) {
+	ctx := context.Background()
+	var dropped []string
+	client, err := zilliz.NewClient(
+		zilliz.WithApiKey("test-key"),
+		zilliz.WithHTTPClient(&volumeResourceMockHTTPClient{t: t, do: func(call int, req *http.Request, body []byte) (*http.Response, error) {
+			switch req.URL.Path {
+			case "/v2/vectordb/databases/describe":
+				return volumeJSONResponse(t, http.StatusOK, map[string]any{"code": 0, "data": map[string]any{
+					"dbName": "testdb",
+					"properties": []map[string]any{
+						{"key": databaseReplicaNumberProperty, "value": "2"},
+						{"key": databaseForceDenyWritingProperty, "value": "true"},
+						{"key": "database.diskQuota.mb", "value": "1024"},
+					},
+				}}), nil
+			case "/v2/vectordb/databases/drop_properties":
+				var params zilliz.DropDatabasePropertiesParams
+				if err := json.Unmarshal(body, &params); err != nil {
+					t.Fatalf("Unmarshal: %v", err)
+				}
+				dropped = params.PropertyKeys
+				return volumeJSONResponse(t, http.StatusOK, map[string]any{"code": 0, "data": map[string]any{}}), nil
+			}
+			t.Fatalf("unexpected request %s", req.URL.Path)
+			return nil, nil
+		}}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	r := &DatabaseResource{client: client}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	newModel := func(forceDenyWriting types.Bool, props map[string]attr.Value) *DatabaseResourceModel {
+		return &DatabaseResourceModel{
+			Id:               types.StringValue("/connections/in01-test.zillizcloud.com:19530/databases/testdb"),
+			ConnectAddress:   types.StringValue("https://in01-test.zillizcloud.com:19530"),
+			DbName:           types.StringValue("testdb"),
+			Properties:       types.MapValueMust(types.StringType, props),
+			ReplicaNumber:    types.Int64Value(2),
+			MaxCollections:   types.Int64Null(),
+			ForceDenyWriting: forceDenyWriting,
+			ForceDenyReading: types.BoolNull(),
+			MmapEnabled:      types.BoolNull(),
+		}
+	}
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	state.Set(ctx, newModel(types.BoolValue(true), map[string]attr.Value{"database.diskQuota.mb": types.StringValue("1024")}))
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	plan.Set(ctx, newModel(types.BoolNull(), map[string]attr.Value{}))
+
+	resp := fwresource.UpdateResponse{State: state}
+	r.Update(ctx, fwresource.UpdateRequest{Plan: plan, State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update diagnostics: %v", resp.Diagnostics)
+	}
+	if len(dropped) != 2 || dropped[0] != "database.diskQuota.mb" || dropped[1] != databaseForceDenyWritingProperty {
+		t.Errorf("dropped properties = %v, want database.diskQuota.mb and %s", dropped, databaseForceDenyWritingProperty)
+	}
+}
@@ -0,0 +1,370 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+)
+
+var _ resource.Resource = &PrivilegeGroupResource{}
+var _ resource.ResourceWithConfigure = &PrivilegeGroupResource{}
+var _ resource.ResourceWithImportState = &PrivilegeGroupResource{}
+var _ resource.ResourceWithValidateConfig = &PrivilegeGroupResource{}
+
+func NewPrivilegeGroupResource() resource.Resource {
+	return &PrivilegeGroupResource{}
+}
+
+type PrivilegeGroupResource struct {
+	client *zilliz.Client
+}
+
+type PrivilegeGroupResourceModel struct {
+	Id                 types.String   `tfsdk:"id"` // /connections/{connect_address}/privilege_groups/{privilege_group_name}
+	ConnectAddress     types.String   `tfsdk:"connect_address"`
+	PrivilegeGroupName types.String   `tfsdk:"privilege_group_name"`
+	Privileges         []types.String `tfsdk:"privileges"`
+}
+
+func (r *PrivilegeGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_privilege_group"
+}
+
+func (r *PrivilegeGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages a custom privilege group in a Zilliz Cloud cluster.
+
+A privilege group bundles privileges so they can be granted together through the ` + "`privilege_groups`" + ` attribute of ` + "`zillizcloud_role`" + `.
+Built-in groups such as ` + "`COLL_RO`" + ` are listed by the ` + "`zillizcloud_privilege_groups`" + ` data source and cannot be managed here.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: `The unique identifier for the privilege group.
+
+**Format:**
+` + "`" + `/connections/{connect_address}/privilege_groups/{privilege_group_name}` + "`" + `
+
+> **Note:** This value is automatically set and should not be manually specified.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connect_address": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: `The connection address of the target Zilliz Cloud cluster.
+You can obtain this value from the output of the ` + "`zillizcloud_cluster`" + ` resource, for example:
+` + "`zillizcloud_cluster.example.connect_address`" + `
+
+**Example:**
+` + "`https://in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534`" + `
+
+> **Note:** The address must include the protocol (e.g., ` + "`https://`" + `).`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"privilege_group_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: `The name of the privilege group. Changing this value will force resource replacement.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"privileges": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: `The privileges in the group, for example ` + "`[\"Search\", \"Query\"]`" + `.
+Privileges are added to and removed from the group in place.`,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *PrivilegeGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*zilliz.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected provider type",
+			fmt.Sprintf("Expected *zilliz.Client, got: %T. Please check provider configuration.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *PrivilegeGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var name types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("privilege_group_name"), &name)...)
+	if !name.IsNull() && !name.IsUnknown() && slices.Contains(zilliz.BuiltinPrivilegeGroups, name.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("privilege_group_name"),
+			"Built-in privilege group",
+			fmt.Sprintf("%q is a built-in privilege group and cannot be managed. Grant it with the privilege_groups attribute of zillizcloud_role instead.", name.ValueString()),
+		)
+	}
+}
+
+// BuildPrivilegeGroupID returns the RESTful ID for a privilege group resource.
+func BuildPrivilegeGroupID(connectAddress, name string) string {
+	return fmt.Sprintf("/connections/%s/privilege_groups/%s", connectAddress, name)
+}
+
+// ParsePrivilegeGroupID parses the RESTful ID and returns connectAddress and the group name.
+func ParsePrivilegeGroupID(id string) (connectAddress, name string, ok bool) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 5 || parts[1] != "connections" || parts[3] != "privilege_groups" {
+		return "", "", false
+	}
+	return parts[2], parts[4], true
+}
+
+// findPrivilegeGroup returns the named group, or nil when it does not exist.
+func findPrivilegeGroup(client *zilliz.ClientPrivilegeGroup, name string) (*zilliz.PrivilegeGroup, error) {
+	groups, err := client.ListPrivilegeGroups()
+	if err != nil {
+		return nil, err
+	}
+	for i := range groups {
+		if groups[i].Name == name {
+			return &groups[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func privilegeStrings(values []types.String) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		result = append(result, v.ValueString())
+	}
+	return result
+}
+
+func (r *PrivilegeGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PrivilegeGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectAddress := data.ConnectAddress.ValueString()
+	client, err := r.client.PrivilegeGroup(connectAddress)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get privilege group client",
+			fmt.Sprintf("ConnectAddress: %s, error: %s", connectAddress, err.Error()),
+		)
+		return
+	}
+
+	name := data.PrivilegeGroupName.ValueString()
+	err = client.CreatePrivilegeGroup(&zilliz.PrivilegeGroupParams{PrivilegeGroupName: name})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create privilege group",
+			fmt.Sprintf("ConnectAddress: %s, PrivilegeGroupName: %s, error: %s", connectAddress, name, err.Error()),
+		)
+		return
+	}
+	// Save the group before adding privileges so a failed add doesn't orphan it.
+	data.Id = types.StringValue(BuildPrivilegeGroupID(NormalizeConnectionID(connectAddress), name))
+	privileges := data.Privileges
+	data.Privileges = nil
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = client.AddPrivilegesToGroup(&zilliz.PrivilegeGroupPrivilegesParams{
+		PrivilegeGroupName: name,
+		Privileges:         privilegeStrings(privileges),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to add privileges to group",
+			fmt.Sprintf("ConnectAddress: %s, PrivilegeGroupName: %s, error: %s", connectAddress, name, err.Error()),
+		)
+		return
+	}
+
+	data.Privileges = privileges
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PrivilegeGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state PrivilegeGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectAddress := state.ConnectAddress.ValueString()
+	client, err := r.client.PrivilegeGroup(connectAddress)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get privilege group client",
+			fmt.Sprintf("ConnectAddress: %s, error: %s", connectAddress, err.Error()),
+		)
+		return
+	}
+
+	group, err := findPrivilegeGroup(client, state.PrivilegeGroupName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to list privilege groups",
+			fmt.Sprintf("ConnectAddress: %s, error: %s", connectAddress, err.Error()),
+		)
+		return
+	}
+	if group == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Privileges = make([]types.String, 0, len(group.Privileges))
+	for _, p := range group.Privileges {
+		state.Privileges = append(state.Privileges, types.StringValue(p))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *PrivilegeGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state PrivilegeGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectAddress := plan.ConnectAddress.ValueString()
+	client, err := r.client.PrivilegeGroup(connectAddress)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get privilege group client",
+			fmt.Sprintf("ConnectAddress: %s, error: %s", connectAddress, err.Error()),
+		)
+		return
+	}
+
+	current, desired := privilegeStrings(state.Privileges), privilegeStrings(plan.Privileges)
+	var remove, add []string
+	for _, p := range current {
+		if !slices.Contains(desired, p) {
+			remove = append(remove, p)
+		}
+	}
+	for _, p := range desired {
+		if !slices.Contains(current, p) {
+			add = append(add, p)
+		}
+	}
+
+	name := plan.PrivilegeGroupName.ValueString()
+	if len(remove) > 0 {
+		err = client.RemovePrivilegesFromGroup(&zilliz.PrivilegeGroupPrivilegesParams{PrivilegeGroupName: name, Privileges: remove})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to remove privileges from group",
+				fmt.Sprintf("ConnectAddress: %s, PrivilegeGroupName: %s, Privileges: %s, error: %s", connectAddress, name, strings.Join(remove, ","), err.Error()),
+			)
+			return
+		}
+	}
+	if len(add) > 0 {
+		err = client.AddPrivilegesToGroup(&zilliz.PrivilegeGroupPrivilegesParams{PrivilegeGroupName: name, Privileges: add})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to add privileges to group",
+				fmt.Sprintf("ConnectAddress: %s, PrivilegeGroupName: %s, Privileges: %s, error: %s", connectAddress, name, strings.Join(add, ","), err.Error()),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PrivilegeGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PrivilegeGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectAddress := state.ConnectAddress.ValueString()
+	client, err := r.client.PrivilegeGroup(connectAddress)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get privilege group client",
+			fmt.Sprintf("ConnectAddress: %s, error: %s", connectAddress, err.Error()),
+		)
+		return
+	}
+
+	err = client.DropPrivilegeGroup(&zilliz.PrivilegeGroupParams{PrivilegeGroupName: state.PrivilegeGroupName.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to drop privilege group",
+			fmt.Sprintf("ConnectAddress: %s, PrivilegeGroupName: %s, error: %s", connectAddress, state.PrivilegeGroupName.ValueString(), err.Error()),
+		)
+	}
+}
+
+func (r *PrivilegeGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Parse import ID, format: "/connections/{connect_address}/privilege_groups/{privilege_group_name}"
+	connectAddress, name, ok := ParsePrivilegeGroupID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Invalid import ID format",
+			"Import ID must be in the format '/connections/{connect_address}/privilege_groups/{privilege_group_name}'",
+		)
+		return
+	}
+
+	connectAddress = "https://" + connectAddress
+
+	client, err := r.client.PrivilegeGroup(connectAddress)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get privilege group client (import)", fmt.Sprintf("ConnectAddress: %s, error: %s", connectAddress, err))
+		return
+	}
+
+	group, err := findPrivilegeGroup(client, name)
+	if err == nil && group == nil {
+		err = fmt.Errorf("privilege group not found")
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to import privilege group: group does not exist or cannot be retrieved",
+			fmt.Sprintf("ConnectAddress: %s, PrivilegeGroupName: %s, error: %s", connectAddress, name, err.Error()),
+		)
+		return
+	}
+
+	state := PrivilegeGroupResourceModel{
+		Id:                 types.StringValue(req.ID),
+		ConnectAddress:     types.StringValue(connectAddress),
+		PrivilegeGroupName: types.StringValue(name),
+	}
+	for _, p := range group.Privileges {
+		state.Privileges = append(state.Privileges, types.StringValue(p))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,78 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/zilliztech/terraform-provider-zillizcloud/internal/provider"
+)
+
+func TestAccPrivilegeGroupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Step 1: Create a custom group and grant it to a role
+			{
+				Config: provider.ProviderConfig + `
+resource "zillizcloud_privilege_group" "test" {
+  connect_address      = "https://in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534"
+  privilege_group_name = "testgroup"
+  privileges           = ["Search", "Query"]
+}
+resource "zillizcloud_role" "test" {
+  connect_address = zillizcloud_privilege_group.test.connect_address
+  role_name       = "testgrouprole"
+  privilege_groups = [
+    {
+      privilege_group = zillizcloud_privilege_group.test.privilege_group_name
+    },
+    {
+      privilege_group = "DB_RO"
+      db_name         = "default"
+    }
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zillizcloud_privilege_group.test", "privileges.#", "2"),
+					resource.TestCheckResourceAttr("zillizcloud_role.test", "privilege_groups.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("zillizcloud_role.test", "privilege_groups.*", map[string]string{
+						"privilege_group": "DB_RO",
+						"db_name":         "default",
+						"collection_name": "*",
+					}),
+				),
+			},
+			// Step 2: Change the group's privileges in place
+			{
+				Config: provider.ProviderConfig + `
+resource "zillizcloud_privilege_group" "test" {
+  connect_address      = "https://in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534"
+  privilege_group_name = "testgroup"
+  privileges           = ["Search", "DescribeCollection"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("zillizcloud_privilege_group.test", "privileges.*", "DescribeCollection"),
+					resource.TestCheckResourceAttr("zillizcloud_privilege_group.test", "privileges.#", "2"),
+				),
+			},
+			// Step 3: Import privilege group
+			{
+				ResourceName:      "zillizcloud_privilege_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					rs, ok := state.RootModule().Resources["zillizcloud_privilege_group.test"]
+					if !ok {
+						return "", fmt.Errorf("zillizcloud_privilege_group.test not found")
+					}
+					connectAddress := rs.Primary.Attributes["connect_address"][len("https://"):]
+					return fmt.Sprintf("/connections/%s/privilege_groups/%s", connectAddress, rs.Primary.Attributes["privilege_group_name"]), nil
+				},
+			},
+		},
+	})
+}
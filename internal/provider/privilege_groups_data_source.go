@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+)
+
+var _ datasource.DataSource = &PrivilegeGroupsDataSource{}
+
+func NewPrivilegeGroupsDataSource() datasource.DataSource {
+	return &PrivilegeGroupsDataSource{}
+}
+
+type PrivilegeGroupsDataSource struct {
+	client *zilliz.Client
+}
+
+type PrivilegeGroupItem struct {
+	Name       types.String   `tfsdk:"name"`
+	Builtin    types.Bool     `tfsdk:"builtin"`
+	Privileges []types.String `tfsdk:"privileges"`
+}
+
+type PrivilegeGroupsDataSourceModel struct {
+	ConnectAddress types.String         `tfsdk:"connect_address"`
+	BuiltinOnly    types.Bool           `tfsdk:"builtin_only"`
+	Items          []PrivilegeGroupItem `tfsdk:"items"`
+}
+
+func (d *PrivilegeGroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_privilege_groups"
+}
+
+func (d *PrivilegeGroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List privilege groups of a given cluster by connect_address, with the privileges each group expands to",
+
+		Attributes: map[string]schema.Attribute{
+			"connect_address": schema.StringAttribute{
+				MarkdownDescription: `The connection address of the target Zilliz Cloud cluster.
+You can obtain this value from the output of the ` + "`zillizcloud_cluster`" + ` resource, for example:
+` + "`zillizcloud_cluster.example.connect_address`" + `
+
+**Example:**
+` + "`https://in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534`" + `
+
+> **Note:** The address must include the protocol (e.g., ` + "`https://`" + `).`,
+				Required: true,
+			},
+			"builtin_only": schema.BoolAttribute{
+				MarkdownDescription: "Only list the built-in privilege groups, such as `COLL_RO`, `DB_RW` and `Cluster_Admin`.",
+				Optional:            true,
+			},
+			"items": schema.ListNestedAttribute{
+				MarkdownDescription: "List of privilege groups",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Privilege group name",
+							Computed:            true,
+						},
+						"builtin": schema.BoolAttribute{
+							MarkdownDescription: "Whether the group is predefined by Milvus",
+							Computed:            true,
+						},
+						"privileges": schema.ListAttribute{
+							MarkdownDescription: "Privileges in the group",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PrivilegeGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*zilliz.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zilliz.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PrivilegeGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state PrivilegeGroupsDataSourceModel
+
+	// Parse config input
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.PrivilegeGroup(state.ConnectAddress.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to get privilege group client for connect_address %q: %s", state.ConnectAddress.ValueString(), err))
+		return
+	}
+
+	groups, err := client.ListPrivilegeGroups()
+	if err != nil {
+		resp.Diagnostics.AddError("List Privilege Groups Error", fmt.Sprintf("Failed to list privilege groups for connect_address %q: %s", state.ConnectAddress.ValueString(), err))
+		return
+	}
+
+	state.Items = []PrivilegeGroupItem{}
+	for _, g := range groups {
+		builtin := slices.Contains(zilliz.BuiltinPrivilegeGroups, g.Name)
+		if state.BuiltinOnly.ValueBool() && !builtin {
+			continue
+		}
+		item := PrivilegeGroupItem{
+			Name:       types.StringValue(g.Name),
+			Builtin:    types.BoolValue(builtin),
+			Privileges: make([]types.String, 0, len(g.Privileges)),
+		}
+		for _, p := range g.Privileges {
+			item.Privileges = append(item.Privileges, types.StringValue(p))
+		}
+		state.Items = append(state.Items, item)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -10,6 +10,7 @@ import (
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -25,6 +26,7 @@ import (
 
 // Ensure ZillizProvider satisfies various provider interfaces.
 var _ provider.Provider = &ZillizProvider{}
+var _ provider.ProviderWithEphemeralResources = &ZillizProvider{}
 
 // ZillizProvider defines the provider implementation.
 type ZillizProvider struct {
@@ -103,6 +105,7 @@ func (p *ZillizProvider) Configure(ctx context.Context, req provider.ConfigureRe
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
 }
 
 type clientConfig struct {
@@ -175,6 +178,7 @@ func (p *ZillizProvider) Resources(ctx context.Context) []func() resource.Resour
 		global_cluster.NewGlobalClusterResource,
 		on_demand_cluster.NewOnDemandClusterResource,
 		cluster.NewClusterLoadBalancerSecurityGroupsResource,
+		cluster.NewClusterIpAllowlistResource,
 		byoc.NewBYOCProjectResource,
 		byoc_op.NewBYOCOpProjectSettingsResource,
 		byoc_op.NewBYOCOpProjectResource,
@@ -183,10 +187,16 @@ func (p *ZillizProvider) Resources(ctx context.Context) []func() resource.Resour
 		NewApiKeyResource,
 		NewUserResource,
 		NewUserRoleResource,
+		NewUserRoleAttachmentResource,
+		NewRoleResource,
+		NewPrivilegeGroupResource,
+		NewResourceGroupResource,
 		NewDatabaseResource,
 		NewCollectionResource,
 		NewIndexResource,
+		NewCollectionLoadResource,
 		NewAliasResource,
+		NewCollectionRolloutResource,
 		NewPartitionsResource,
 		NewBackupPolicyResource,
 		NewEndpointResource,
@@ -199,16 +209,22 @@ func (p *ZillizProvider) DataSources(ctx context.Context) []func() datasource.Da
 	return []func() datasource.DataSource{
 		NewCloudProvidersDataSource,
 		NewCloudRegionsDataSource,
+		NewAvailableVersionsDataSource,
 		NewProjectDataSource,
 		cluster.NewClustersDataSource,
 		cluster.NewClusterDataSource,
+		cluster.NewClusterReadyDataSource,
 		byoc.NewExternalIdDataSource,
 		byoc.NewGCPServiceAccountDataSource,
 		byoc_op.NewBYOCOpProjectSettingsData,
 		NewUsersDataSource,
 		NewRolesDataSource,
+		NewPrivilegeGroupsDataSource,
+		NewResourceGroupsDataSource,
 		NewDatabasesDataSource,
+		NewDatabaseDataSource,
 		NewCollectionsDataSource,
+		NewCollectionDataSource,
 		NewIndexesDataSource,
 		NewAliasesDataSource,
 		NewPartitionsDataSource,
@@ -217,6 +233,13 @@ func (p *ZillizProvider) DataSources(ctx context.Context) []func() datasource.Da
 	}
 }
 
+func (p *ZillizProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewClusterCredentialsEphemeralResource,
+		NewApiKeyEphemeralResource,
+	}
+}
+
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &ZillizProvider{
@@ -32,3 +32,15 @@ var (
 func NormalizeConnectionID(connectAddress string) string {
 	return strings.TrimPrefix(connectAddress, "https://")
 }
+
+// ClusterIdFromConnectAddress extracts the cluster ID, the first label of the
+// host, from a connect address such as
+// https://in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534.
+func ClusterIdFromConnectAddress(connectAddress string) (string, bool) {
+	host := NormalizeConnectionID(connectAddress)
+	clusterId, _, ok := strings.Cut(host, ".")
+	if !ok || clusterId == "" {
+		return "", false
+	}
+	return clusterId, true
+}
@@ -0,0 +1,460 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+	util "github.com/zilliztech/terraform-provider-zillizcloud/client/retry"
+)
+
+const defaultResourceGroupReleaseTimeout = 10 * time.Minute
+
+var _ resource.Resource = &ResourceGroupResource{}
+var _ resource.ResourceWithConfigure = &ResourceGroupResource{}
+var _ resource.ResourceWithImportState = &ResourceGroupResource{}
+var _ resource.ResourceWithValidateConfig = &ResourceGroupResource{}
+
+func NewResourceGroupResource() resource.Resource {
+	return &ResourceGroupResource{}
+}
+
+type ResourceGroupResource struct {
+	client *zilliz.Client
+}
+
+type ResourceGroupResourceModel struct {
+	Id               types.String   `tfsdk:"id"` // /connections/{connect_address}/resource_groups/{name}
+	ConnectAddress   types.String   `tfsdk:"connect_address"`
+	Name             types.String   `tfsdk:"name"`
+	RequestsNodeNum  types.Int64    `tfsdk:"requests_node_num"`
+	LimitsNodeNum    types.Int64    `tfsdk:"limits_node_num"`
+	TransferFrom     []types.String `tfsdk:"transfer_from"`
+	TransferTo       []types.String `tfsdk:"transfer_to"`
+	Capacity         types.Int64    `tfsdk:"capacity"`
+	NumAvailableNode types.Int64    `tfsdk:"num_available_node"`
+}
+
+func (r *ResourceGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_group"
+}
+
+func (r *ResourceGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages a resource group in a dedicated Zilliz Cloud cluster.
+
+A resource group reserves a number of query nodes. Databases are pinned to resource groups through the
+` + "`resource_groups`" + ` attribute of ` + "`zillizcloud_database`" + `, which isolates the query nodes of different tenants.
+
+Query nodes are moved between resource groups to satisfy ` + "`requests_node_num`" + ` and ` + "`limits_node_num`" + `. Nodes are borrowed
+from the groups listed in ` + "`transfer_from`" + ` and returned to the groups listed in ` + "`transfer_to`" + `. On destroy, the group is
+shrunk to zero nodes before it is dropped.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: `The unique identifier for the resource group.
+
+**Format:**
+` + "`" + `/connections/{connect_address}/resource_groups/{name}` + "`" + `
+
+> **Note:** This value is automatically set and should not be manually specified.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connect_address": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: `The connection address of the target Zilliz Cloud cluster.
+You can obtain this value from the output of the ` + "`zillizcloud_cluster`" + ` resource, for example:
+` + "`zillizcloud_cluster.example.connect_address`" + `
+
+**Example:**
+` + "`https://in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534`" + `
+
+> **Note:** The address must include the protocol (e.g., ` + "`https://`" + `).`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: `The name of the resource group. Changing this value will force resource replacement.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"requests_node_num": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: `The number of query nodes the resource group requests.`,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"limits_node_num": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: `The maximum number of query nodes the resource group may hold. Must be at least ` + "`requests_node_num`" + `.`,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"transfer_from": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: `Resource groups to borrow query nodes from when this group has fewer nodes than it requests, in order of preference.`,
+			},
+			"transfer_to": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: `Resource groups to return query nodes to when this group holds more nodes than its limit, in order of preference.`,
+			},
+			"capacity": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: `The number of query nodes currently assigned to the resource group.`,
+			},
+			"num_available_node": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: `The number of available query nodes in the resource group.`,
+			},
+		},
+	}
+}
+
+func (r *ResourceGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*zilliz.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected provider type",
+			fmt.Sprintf("Expected *zilliz.Client, got: %T. Please check provider configuration.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *ResourceGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ResourceGroupResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Name.ValueString() == zilliz.DefaultResourceGroup {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Default resource group",
+			fmt.Sprintf("%q is created by the cluster and cannot be managed.", zilliz.DefaultResourceGroup),
+		)
+	}
+
+	requests, limits := data.RequestsNodeNum, data.LimitsNodeNum
+	if !requests.IsNull() && !requests.IsUnknown() && !limits.IsNull() && !limits.IsUnknown() &&
+		limits.ValueInt64() < requests.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("limits_node_num"),
+			"Invalid node limits",
+			fmt.Sprintf("limits_node_num (%d) must be greater than or equal to requests_node_num (%d).", limits.ValueInt64(), requests.ValueInt64()),
+		)
+	}
+}
+
+// BuildResourceGroupID returns the RESTful ID for a resource group resource.
+func BuildResourceGroupID(connectAddress, name string) string {
+	return fmt.Sprintf("/connections/%s/resource_groups/%s", connectAddress, name)
+}
+
+// ParseResourceGroupID parses the RESTful ID and returns connectAddress and the group name.
+func ParseResourceGroupID(id string) (connectAddress, name string, ok bool) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 5 || parts[1] != "connections" || parts[3] != "resource_groups" {
+		return "", "", false
+	}
+	return parts[2], parts[4], true
+}
+
+func (m *ResourceGroupResourceModel) config() *zilliz.ResourceGroupConfig {
+	config := &zilliz.ResourceGroupConfig{
+		Requests: zilliz.ResourceGroupNodeNum{NodeNum: m.RequestsNodeNum.ValueInt64()},
+		Limits:   zilliz.ResourceGroupNodeNum{NodeNum: m.LimitsNodeNum.ValueInt64()},
+	}
+	for _, rg := range m.TransferFrom {
+		config.TransferFrom = append(config.TransferFrom, zilliz.ResourceGroupTransfer{ResourceGroup: rg.ValueString()})
+	}
+	for _, rg := range m.TransferTo {
+		config.TransferTo = append(config.TransferTo, zilliz.ResourceGroupTransfer{ResourceGroup: rg.ValueString()})
+	}
+	return config
+}
+
+func (m *ResourceGroupResourceModel) populate(rg *zilliz.ResourceGroup) {
+	m.RequestsNodeNum = types.Int64Value(rg.Config.Requests.NodeNum)
+	m.LimitsNodeNum = types.Int64Value(rg.Config.Limits.NodeNum)
+	m.Capacity = types.Int64Value(rg.Capacity)
+	m.NumAvailableNode = types.Int64Value(rg.NumAvailableNode)
+	m.TransferFrom = resourceGroupTransferNames(rg.Config.TransferFrom, m.TransferFrom)
+	m.TransferTo = resourceGroupTransferNames(rg.Config.TransferTo, m.TransferTo)
+}
+
+// resourceGroupTransferNames converts transfer rules to state, keeping an
+// unset attribute null when the cluster reports no rules.
+func resourceGroupTransferNames(transfers []zilliz.ResourceGroupTransfer, current []types.String) []types.String {
+	if len(transfers) == 0 && current == nil {
+		return nil
+	}
+	names := make([]types.String, 0, len(transfers))
+	for _, t := range transfers {
+		names = append(names, types.StringValue(t.ResourceGroup))
+	}
+	return names
+}
+
+// describeResourceGroup returns the named group, or nil when it does not exist.
+func describeResourceGroup(client *zilliz.ClientResourceGroup, name string) (*zilliz.ResourceGroup, error) {
+	names, err := client.ListResourceGroups()
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(names, name) {
+		return nil, nil
+	}
+	return client.DescribeResourceGroup(name)
+}
+
+func (r *ResourceGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ResourceGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectAddress := data.ConnectAddress.ValueString()
+	client, err := r.client.ResourceGroup(connectAddress)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get resource group client",
+			fmt.Sprintf("ConnectAddress: %s, error: %s", connectAddress, err.Error()),
+		)
+		return
+	}
+
+	name := data.Name.ValueString()
+	err = client.CreateResourceGroup(&zilliz.CreateResourceGroupParams{
+		Name:   name,
+		Config: data.config(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create resource group",
+			fmt.Sprintf("ConnectAddress: %s, Name: %s, error: %s", connectAddress, name, err.Error()),
+		)
+		return
+	}
+
+	rg, err := client.DescribeResourceGroup(name)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to describe resource group",
+			fmt.Sprintf("ConnectAddress: %s, Name: %s, error: %s", connectAddress, name, err.Error()),
+		)
+		return
+	}
+
+	data.Id = types.StringValue(BuildResourceGroupID(NormalizeConnectionID(connectAddress), name))
+	data.Capacity = types.Int64Value(rg.Capacity)
+	data.NumAvailableNode = types.Int64Value(rg.NumAvailableNode)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ResourceGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ResourceGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectAddress := state.ConnectAddress.ValueString()
+	client, err := r.client.ResourceGroup(connectAddress)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get resource group client",
+			fmt.Sprintf("ConnectAddress: %s, error: %s", connectAddress, err.Error()),
+		)
+		return
+	}
+
+	rg, err := describeResourceGroup(client, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to describe resource group",
+			fmt.Sprintf("ConnectAddress: %s, Name: %s, error: %s", connectAddress, state.Name.ValueString(), err.Error()),
+		)
+		return
+	}
+	if rg == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.populate(rg)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ResourceGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ResourceGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectAddress := plan.ConnectAddress.ValueString()
+	client, err := r.client.ResourceGroup(connectAddress)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get resource group client",
+			fmt.Sprintf("ConnectAddress: %s, error: %s", connectAddress, err.Error()),
+		)
+		return
+	}
+
+	name := plan.Name.ValueString()
+	err = client.UpdateResourceGroups(&zilliz.UpdateResourceGroupsParams{
+		ResourceGroups: map[string]*zilliz.ResourceGroupConfig{name: plan.config()},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update resource group",
+			fmt.Sprintf("ConnectAddress: %s, Name: %s, error: %s", connectAddress, name, err.Error()),
+		)
+		return
+	}
+
+	rg, err := client.DescribeResourceGroup(name)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to describe resource group",
+			fmt.Sprintf("ConnectAddress: %s, Name: %s, error: %s", connectAddress, name, err.Error()),
+		)
+		return
+	}
+
+	plan.Capacity = types.Int64Value(rg.Capacity)
+	plan.NumAvailableNode = types.Int64Value(rg.NumAvailableNode)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ResourceGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ResourceGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectAddress := state.ConnectAddress.ValueString()
+	client, err := r.client.ResourceGroup(connectAddress)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get resource group client",
+			fmt.Sprintf("ConnectAddress: %s, error: %s", connectAddress, err.Error()),
+		)
+		return
+	}
+
+	// A resource group can only be dropped once it holds no query nodes, so
+	// release them back to the default group first.
+	name := state.Name.ValueString()
+	err = client.UpdateResourceGroups(&zilliz.UpdateResourceGroupsParams{
+		ResourceGroups: map[string]*zilliz.ResourceGroupConfig{name: {
+			TransferTo: []zilliz.ResourceGroupTransfer{{ResourceGroup: zilliz.DefaultResourceGroup}},
+		}},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to release resource group nodes",
+			fmt.Sprintf("ConnectAddress: %s, Name: %s, error: %s", connectAddress, name, err.Error()),
+		)
+		return
+	}
+
+	// Nodes are transferred asynchronously, wait for the group to be empty.
+	_, err = util.Poll(ctx, defaultResourceGroupReleaseTimeout, func() (*zilliz.ResourceGroup, *util.Err) {
+		rg, err := client.DescribeResourceGroup(name)
+		if err != nil {
+			return nil, &util.Err{Err: err, Halt: !util.IsNetworkError(err)}
+		}
+		if rg.NumAvailableNode > 0 || len(rg.Nodes) > 0 {
+			return nil, &util.Err{
+				Err:  fmt.Errorf("resource group still holds %d nodes", max(rg.NumAvailableNode, int64(len(rg.Nodes)))),
+				Halt: false,
+			}
+		}
+		return rg, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to release resource group nodes",
+			fmt.Sprintf("ConnectAddress: %s, Name: %s, error: %s", connectAddress, name, err.Error()),
+		)
+		return
+	}
+
+	err = client.DropResourceGroup(name)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to drop resource group",
+			fmt.Sprintf("ConnectAddress: %s, Name: %s, error: %s", connectAddress, name, err.Error()),
+		)
+	}
+}
+
+func (r *ResourceGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Parse import ID, format: "/connections/{connect_address}/resource_groups/{name}"
+	connectAddress, name, ok := ParseResourceGroupID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Invalid import ID format",
+			"Import ID must be in the format '/connections/{connect_address}/resource_groups/{name}'",
+		)
+		return
+	}
+
+	connectAddress = "https://" + connectAddress
+
+	client, err := r.client.ResourceGroup(connectAddress)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get resource group client (import)", fmt.Sprintf("ConnectAddress: %s, error: %s", connectAddress, err))
+		return
+	}
+
+	rg, err := describeResourceGroup(client, name)
+	if err == nil && rg == nil {
+		err = fmt.Errorf("resource group not found")
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to import resource group: group does not exist or cannot be retrieved",
+			fmt.Sprintf("ConnectAddress: %s, Name: %s, error: %s", connectAddress, name, err.Error()),
+		)
+		return
+	}
+
+	state := ResourceGroupResourceModel{
+		Id:             types.StringValue(req.ID),
+		ConnectAddress: types.StringValue(connectAddress),
+		Name:           types.StringValue(name),
+	}
+	state.populate(rg)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,77 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/zilliztech/terraform-provider-zillizcloud/internal/provider"
+)
+
+func TestAccResourceGroupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Step 1: Create a resource group and pin a database to it
+			{
+				Config: provider.ProviderConfig + `
+resource "zillizcloud_resource_group" "test" {
+  connect_address   = "https://in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534"
+  name              = "rg_test"
+  requests_node_num = 1
+  limits_node_num   = 1
+  transfer_from     = ["__default_resource_group"]
+  transfer_to       = ["__default_resource_group"]
+}
+resource "zillizcloud_database" "test" {
+  connect_address = zillizcloud_resource_group.test.connect_address
+  db_name         = "rgtestdb"
+  resource_groups = [zillizcloud_resource_group.test.name]
+  replica_number  = 1
+}
+data "zillizcloud_resource_groups" "test" {
+  connect_address = zillizcloud_resource_group.test.connect_address
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zillizcloud_resource_group.test", "id", "/connections/in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534/resource_groups/rg_test"),
+					resource.TestCheckResourceAttr("zillizcloud_resource_group.test", "requests_node_num", "1"),
+					resource.TestCheckResourceAttrSet("zillizcloud_resource_group.test", "capacity"),
+					resource.TestCheckResourceAttr("zillizcloud_database.test", "resource_groups.0", "rg_test"),
+					resource.TestCheckResourceAttr("zillizcloud_database.test", "replica_number", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.zillizcloud_resource_groups.test", "items.*", map[string]string{
+						"name":              "rg_test",
+						"requests_node_num": "1",
+					}),
+				),
+			},
+			// Step 2: Raise the node limit in place
+			{
+				Config: provider.ProviderConfig + `
+resource "zillizcloud_resource_group" "test" {
+  connect_address   = "https://in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534"
+  name              = "rg_test"
+  requests_node_num = 1
+  limits_node_num   = 2
+  transfer_from     = ["__default_resource_group"]
+  transfer_to       = ["__default_resource_group"]
+}
+resource "zillizcloud_database" "test" {
+  connect_address = zillizcloud_resource_group.test.connect_address
+  db_name         = "rgtestdb"
+  resource_groups = [zillizcloud_resource_group.test.name]
+  replica_number  = 1
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zillizcloud_resource_group.test", "limits_node_num", "2"),
+				),
+			},
+			// Step 3: Import
+			{
+				ResourceName:      "zillizcloud_resource_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
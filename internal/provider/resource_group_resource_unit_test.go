@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+)
+
+func TestResourceGroupResourceDeleteReleasesNodesBeforeDrop(t *testing.T) {
+	ctx := context.Background()
+	var paths []string
+	client, err := zilliz.NewClient(
+		zilliz.WithApiKey("test-key"),
+		zilliz.WithHTTPClient(&volumeResourceMockHTTPClient{t: t, do: func(call int, req *http.Request, body []byte) (*http.Response, error) {
+			paths = append(paths, req.URL.Path)
+			if req.URL.Path == "/v2/vectordb/resource_groups/describe" {
+				// The first describe still reports the node being transferred.
+				nodes := []map[string]any{}
+				if len(paths) == 2 {
+					nodes = append(nodes, map[string]any{"node_id": 1})
+				}
+				return volumeJSONResponse(t, http.StatusOK, map[string]any{"code": 0, "data": map[string]any{
+					"resource_group": map[string]any{"name": "rg_tenant_a", "num_available_node": len(nodes), "nodes": nodes},
+				}}), nil
+			}
+			if req.URL.Path == "/v2/vectordb/resource_groups/alter" {
+				var params zilliz.UpdateResourceGroupsParams
+				if err := json.Unmarshal(body, &params); err != nil {
+					t.Fatalf("unmarshal: %v", err)
+				}
+				config := params.ResourceGroups["rg_tenant_a"]
+				if config == nil || config.Requests.NodeNum != 0 || config.Limits.NodeNum != 0 {
+					t.Errorf("alter params = %s", body)
+				}
+			}
+			return volumeJSONResponse(t, http.StatusOK, map[string]any{"code": 0, "data": map[string]any{}}), nil
+		}}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	r := &ResourceGroupResource{client: client}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	model := ResourceGroupResourceModel{
+		Id:               types.StringValue("/connections/in01-test.zillizcloud.com:19530/resource_groups/rg_tenant_a"),
+		ConnectAddress:   types.StringValue("https://in01-test.zillizcloud.com:19530"),
+		Name:             types.StringValue("rg_tenant_a"),
+		RequestsNodeNum:  types.Int64Value(2),
+		LimitsNodeNum:    types.Int64Value(2),
+		Capacity:         types.Int64Value(2),
+		NumAvailableNode: types.Int64Value(2),
+	}
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := state.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("State.Set: %v", diags)
+	}
+
+	var resp fwresource.DeleteResponse
+	r.Delete(ctx, fwresource.DeleteRequest{State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Delete: %v", resp.Diagnostics)
+	}
+	want := []string{
+		"/v2/vectordb/resource_groups/alter",
+		"/v2/vectordb/resource_groups/describe",
+		"/v2/vectordb/resource_groups/describe",
+		"/v2/vectordb/resource_groups/drop",
+	}
+	if len(paths) != len(want) {
+		t.Fatalf("requests = %v, want %v", paths, want)
+	}
+	for i := range want {
+		if paths[i] != want[i] {
+			t.Fatalf("requests = %v, want %v", paths, want)
+		}
+	}
+}
+
+func TestParseResourceGroupID(t *testing.T) {
+	addr, name, ok := ParseResourceGroupID("/connections/in01-test.zillizcloud.com:19530/resource_groups/rg_tenant_a")
+	if !ok || addr != "in01-test.zillizcloud.com:19530" || name != "rg_tenant_a" {
+		t.Fatalf("ParseResourceGroupID = %q, %q, %v", addr, name, ok)
+	}
+	if _, _, ok := ParseResourceGroupID("/connections/in01-test.zillizcloud.com:19530/databases/db"); ok {
+		t.Fatal("expected database ID to be rejected")
+	}
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+)
+
+var _ datasource.DataSource = &ResourceGroupsDataSource{}
+
+func NewResourceGroupsDataSource() datasource.DataSource {
+	return &ResourceGroupsDataSource{}
+}
+
+type ResourceGroupsDataSource struct {
+	client *zilliz.Client
+}
+
+type ResourceGroupItem struct {
+	Name             types.String   `tfsdk:"name"`
+	Capacity         types.Int64    `tfsdk:"capacity"`
+	NumAvailableNode types.Int64    `tfsdk:"num_available_node"`
+	RequestsNodeNum  types.Int64    `tfsdk:"requests_node_num"`
+	LimitsNodeNum    types.Int64    `tfsdk:"limits_node_num"`
+	TransferFrom     []types.String `tfsdk:"transfer_from"`
+	TransferTo       []types.String `tfsdk:"transfer_to"`
+}
+
+type ResourceGroupsDataSourceModel struct {
+	ConnectAddress types.String        `tfsdk:"connect_address"`
+	Items          []ResourceGroupItem `tfsdk:"items"`
+}
+
+func (d *ResourceGroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_groups"
+}
+
+func (d *ResourceGroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List resource groups of a given cluster by connect_address, including the default resource group",
+
+		Attributes: map[string]schema.Attribute{
+			"connect_address": schema.StringAttribute{
+				MarkdownDescription: `The connection address of the target Zilliz Cloud cluster.
+You can obtain this value from the output of the ` + "`zillizcloud_cluster`" + ` resource, for example:
+` + "`zillizcloud_cluster.example.connect_address`" + `
+
+**Example:**
+` + "`https://in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534`" + `
+
+> **Note:** The address must include the protocol (e.g., ` + "`https://`" + `).`,
+				Required: true,
+			},
+			"items": schema.ListNestedAttribute{
+				MarkdownDescription: "List of resource groups",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Resource group name",
+							Computed:            true,
+						},
+						"capacity": schema.Int64Attribute{
+							MarkdownDescription: "Number of query nodes assigned to the group",
+							Computed:            true,
+						},
+						"num_available_node": schema.Int64Attribute{
+							MarkdownDescription: "Number of available query nodes in the group",
+							Computed:            true,
+						},
+						"requests_node_num": schema.Int64Attribute{
+							MarkdownDescription: "Number of query nodes the group requests",
+							Computed:            true,
+						},
+						"limits_node_num": schema.Int64Attribute{
+							MarkdownDescription: "Maximum number of query nodes the group may hold",
+							Computed:            true,
+						},
+						"transfer_from": schema.ListAttribute{
+							MarkdownDescription: "Resource groups the group borrows query nodes from",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"transfer_to": schema.ListAttribute{
+							MarkdownDescription: "Resource groups the group returns query nodes to",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ResourceGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*zilliz.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zilliz.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ResourceGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ResourceGroupsDataSourceModel
+
+	// Parse config input
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.ResourceGroup(state.ConnectAddress.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to get resource group client for connect_address %q: %s", state.ConnectAddress.ValueString(), err))
+		return
+	}
+
+	names, err := client.ListResourceGroups()
+	if err != nil {
+		resp.Diagnostics.AddError("List Resource Groups Error", fmt.Sprintf("Failed to list resource groups for connect_address %q: %s", state.ConnectAddress.ValueString(), err))
+		return
+	}
+
+	state.Items = []ResourceGroupItem{}
+	for _, name := range names {
+		rg, err := client.DescribeResourceGroup(name)
+		if err != nil {
+			resp.Diagnostics.AddError("Describe Resource Group Error", fmt.Sprintf("Failed to describe resource group %q for connect_address %q: %s", name, state.ConnectAddress.ValueString(), err))
+			return
+		}
+		state.Items = append(state.Items, ResourceGroupItem{
+			Name:             types.StringValue(name),
+			Capacity:         types.Int64Value(rg.Capacity),
+			NumAvailableNode: types.Int64Value(rg.NumAvailableNode),
+			RequestsNodeNum:  types.Int64Value(rg.Config.Requests.NodeNum),
+			LimitsNodeNum:    types.Int64Value(rg.Config.Limits.NodeNum),
+			TransferFrom:     resourceGroupTransferNames(rg.Config.TransferFrom, []types.String{}),
+			TransferTo:       resourceGroupTransferNames(rg.Config.TransferTo, []types.String{}),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,493 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+)
+
+var _ resource.Resource = &RoleResource{}
+var _ resource.ResourceWithConfigure = &RoleResource{}
+var _ resource.ResourceWithImportState = &RoleResource{}
+
+func NewRoleResource() resource.Resource {
+	return &RoleResource{}
+}
+
+type RoleResource struct {
+	client *zilliz.Client
+}
+
+type RoleResourceModel struct {
+	Id              types.String              `tfsdk:"id"` // /connections/{connect_address}/roles/{role_name}
+	ConnectAddress  types.String              `tfsdk:"connect_address"`
+	RoleName        types.String              `tfsdk:"role_name"`
+	Privileges      []RolePrivilegeModel      `tfsdk:"privileges"`
+	PrivilegeGroups []RolePrivilegeGroupModel `tfsdk:"privilege_groups"`
+}
+
+type RolePrivilegeModel struct {
+	ObjectType types.String `tfsdk:"object_type"`
+	ObjectName types.String `tfsdk:"object_name"`
+	DbName     types.String `tfsdk:"db_name"`
+	Privilege  types.String `tfsdk:"privilege"`
+}
+
+type RolePrivilegeGroupModel struct {
+	PrivilegeGroup types.String `tfsdk:"privilege_group"`
+	DbName         types.String `tfsdk:"db_name"`
+	CollectionName types.String `tfsdk:"collection_name"`
+}
+
+func (r *RoleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+func (r *RoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages a custom role and its privileges in a Zilliz Cloud cluster.
+
+The ` + "`privileges`" + ` and ` + "`privilege_groups`" + ` sets are authoritative: grants made to the role outside Terraform are revoked on the next apply.
+Assign the role to users with the ` + "`zillizcloud_user_role`" + ` resource.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: `The unique identifier for the role.
+
+**Format:**
+` + "`" + `/connections/{connect_address}/roles/{role_name}` + "`" + `
+
+> **Note:** This value is automatically set and should not be manually specified.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connect_address": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: `The connection address of the target Zilliz Cloud cluster.
+You can obtain this value from the output of the ` + "`zillizcloud_cluster`" + ` resource, for example:
+` + "`zillizcloud_cluster.example.connect_address`" + `
+
+**Example:**
+` + "`https://in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534`" + `
+
+> **Note:** The address must include the protocol (e.g., ` + "`https://`" + `).`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: `The name of the role. Changing this value will force resource replacement.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"privileges": schema.SetNestedAttribute{
+				Optional: true,
+				MarkdownDescription: `The privileges granted to the role. Only the differences from the current grants are applied.
+
+**Example:**
+` + "`" + `{ object_type = "Collection", object_name = "*", db_name = "mydb", privilege = "Search" }` + "`",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"object_type": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: `The type of object the privilege applies to: ` + "`Global`" + `, ` + "`Collection`" + ` or ` + "`User`" + `.`,
+						},
+						"object_name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: `The name of the object, or ` + "`*`" + ` for all objects of the type.`,
+						},
+						"db_name": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("default"),
+							MarkdownDescription: `The database the object belongs to. Defaults to ` + "`default`" + `.`,
+						},
+						"privilege": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: `The privilege to grant, for example ` + "`Search`" + `, ` + "`Query`" + ` or ` + "`Insert`" + `.`,
+						},
+					},
+				},
+			},
+			"privilege_groups": schema.SetNestedAttribute{
+				Optional: true,
+				MarkdownDescription: `The privilege groups granted to the role, either built-in groups such as ` + "`COLL_RO`" + `, ` + "`DB_RW`" + ` and ` + "`Cluster_Admin`" + `,
+or custom groups managed with ` + "`zillizcloud_privilege_group`" + `.
+
+**Example:**
+` + "`" + `{ privilege_group = "COLL_RO", db_name = "mydb", collection_name = "*" }` + "`",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"privilege_group": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: `The name of the privilege group.`,
+						},
+						"db_name": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("*"),
+							MarkdownDescription: `The database the group is granted on, or ` + "`*`" + ` for all databases. Defaults to ` + "`*`" + `.`,
+						},
+						"collection_name": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("*"),
+							MarkdownDescription: `The collection the group is granted on, or ` + "`*`" + ` for all collections. Defaults to ` + "`*`" + `.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *RoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*zilliz.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected provider type",
+			fmt.Sprintf("Expected *zilliz.Client, got: %T. Please check provider configuration.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// BuildRoleID returns the RESTful ID for a role resource.
+func BuildRoleID(connectAddress, roleName string) string {
+	return fmt.Sprintf("/connections/%s/roles/%s", connectAddress, roleName)
+}
+
+// ParseRoleID parses the RESTful ID and returns connectAddress and roleName.
+func ParseRoleID(id string) (connectAddress, roleName string, ok bool) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 5 || parts[1] != "connections" || parts[3] != "roles" {
+		return "", "", false
+	}
+	return parts[2], parts[4], true
+}
+
+func (p RolePrivilegeModel) params(roleName string) *zilliz.RolePrivilegeParams {
+	return &zilliz.RolePrivilegeParams{
+		RoleName:   roleName,
+		ObjectType: p.ObjectType.ValueString(),
+		ObjectName: p.ObjectName.ValueString(),
+		DbName:     p.DbName.ValueString(),
+		Privilege:  p.Privilege.ValueString(),
+	}
+}
+
+func (p RolePrivilegeModel) String() string {
+	return fmt.Sprintf("%s/%s/%s/%s", p.ObjectType.ValueString(), p.DbName.ValueString(), p.ObjectName.ValueString(), p.Privilege.ValueString())
+}
+
+func (g RolePrivilegeGroupModel) params(roleName string) *zilliz.RolePrivilegeV2Params {
+	return &zilliz.RolePrivilegeV2Params{
+		RoleName:       roleName,
+		Privilege:      g.PrivilegeGroup.ValueString(),
+		DbName:         g.DbName.ValueString(),
+		CollectionName: g.CollectionName.ValueString(),
+	}
+}
+
+func (g RolePrivilegeGroupModel) String() string {
+	return fmt.Sprintf("%s/%s/%s", g.PrivilegeGroup.ValueString(), g.DbName.ValueString(), g.CollectionName.ValueString())
+}
+
+// diffRolePrivileges returns the grants to revoke and to add to turn current into desired.
+func diffRolePrivileges[T fmt.Stringer](current, desired []T) (revoke, grant []T) {
+	contains := func(list []T, p T) bool {
+		return slices.ContainsFunc(list, func(q T) bool { return q.String() == p.String() })
+	}
+	for _, p := range current {
+		if !contains(desired, p) {
+			revoke = append(revoke, p)
+		}
+	}
+	for _, p := range desired {
+		if !contains(current, p) {
+			grant = append(grant, p)
+		}
+	}
+	return revoke, grant
+}
+
+// convertRolePrivileges splits the grants of a role into privileges and privilege groups.
+// Describe reports both the same way, so grants are recognized as groups by name.
+func convertRolePrivileges(privileges []zilliz.RolePrivilege, groupNames []string) ([]RolePrivilegeModel, []RolePrivilegeGroupModel) {
+	var result []RolePrivilegeModel
+	var groups []RolePrivilegeGroupModel
+	for _, p := range privileges {
+		if slices.Contains(groupNames, p.Privilege) {
+			groups = append(groups, RolePrivilegeGroupModel{
+				PrivilegeGroup: types.StringValue(p.Privilege),
+				DbName:         types.StringValue(p.DbName),
+				CollectionName: types.StringValue(p.ObjectName),
+			})
+			continue
+		}
+		result = append(result, RolePrivilegeModel{
+			ObjectType: types.StringValue(p.ObjectType),
+			ObjectName: types.StringValue(p.ObjectName),
+			DbName:     types.StringValue(p.DbName),
+			Privilege:  types.StringValue(p.Privilege),
+		})
+	}
+	return result, groups
+}
+
+// rolePrivilegeGroupNames returns the names treated as privilege groups when reading a role:
+// the built-in groups plus the groups already configured on it.
+func rolePrivilegeGroupNames(groups []RolePrivilegeGroupModel) []string {
+	names := slices.Clone(zilliz.BuiltinPrivilegeGroups)
+	for _, g := range groups {
+		names = append(names, g.PrivilegeGroup.ValueString())
+	}
+	return names
+}
+
+// applyRolePrivileges revokes before granting, so a failed apply never leaves the role with more access than either configuration.
+func applyRolePrivileges(client *zilliz.ClientRole, roleName string, revoke, grant []RolePrivilegeModel, revokeGroups, grantGroups []RolePrivilegeGroupModel) error {
+	for _, p := range revoke {
+		if err := client.RevokePrivilege(p.params(roleName)); err != nil {
+			return fmt.Errorf("revoke %s: %w", p, err)
+		}
+	}
+	for _, g := range revokeGroups {
+		if err := client.RevokePrivilegeV2(g.params(roleName)); err != nil {
+			return fmt.Errorf("revoke privilege group %s: %w", g, err)
+		}
+	}
+	for _, p := range grant {
+		if err := client.GrantPrivilege(p.params(roleName)); err != nil {
+			return fmt.Errorf("grant %s: %w", p, err)
+		}
+	}
+	for _, g := range grantGroups {
+		if err := client.GrantPrivilegeV2(g.params(roleName)); err != nil {
+			return fmt.Errorf("grant privilege group %s: %w", g, err)
+		}
+	}
+	return nil
+}
+
+func (r *RoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectAddress := data.ConnectAddress.ValueString()
+	client, err := r.client.Role(connectAddress)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get role client",
+			fmt.Sprintf("ConnectAddress: %s, error: %s", connectAddress, err.Error()),
+		)
+		return
+	}
+
+	err = client.CreateRole(&zilliz.CreateRoleParams{RoleName: data.RoleName.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create role",
+			fmt.Sprintf("ConnectAddress: %s, RoleName: %s, error: %s", connectAddress, data.RoleName.ValueString(), err.Error()),
+		)
+		return
+	}
+	// Save the role before granting so a failed grant doesn't orphan it.
+	data.Id = types.StringValue(BuildRoleID(NormalizeConnectionID(connectAddress), data.RoleName.ValueString()))
+	privileges, groups := data.Privileges, data.PrivilegeGroups
+	data.Privileges, data.PrivilegeGroups = nil, nil
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = applyRolePrivileges(client, data.RoleName.ValueString(), nil, privileges, nil, groups)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to grant privileges to role",
+			fmt.Sprintf("ConnectAddress: %s, RoleName: %s, error: %s", connectAddress, data.RoleName.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	data.Privileges, data.PrivilegeGroups = privileges, groups
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectAddress := state.ConnectAddress.ValueString()
+	client, err := r.client.Role(connectAddress)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get role client",
+			fmt.Sprintf("ConnectAddress: %s, error: %s", connectAddress, err.Error()),
+		)
+		return
+	}
+
+	roles, err := client.ListRoles()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to list roles",
+			fmt.Sprintf("ConnectAddress: %s, error: %s", connectAddress, err.Error()),
+		)
+		return
+	}
+	if !slices.Contains(roles, state.RoleName.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	privileges, err := client.DescribeRole(&zilliz.DescribeRoleParams{RoleName: state.RoleName.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to describe role",
+			fmt.Sprintf("ConnectAddress: %s, RoleName: %s, error: %s", connectAddress, state.RoleName.ValueString(), err.Error()),
+		)
+		return
+	}
+	current, groups := convertRolePrivileges(privileges, rolePrivilegeGroupNames(state.PrivilegeGroups))
+	// Keep an explicitly empty set from showing up as a change to null.
+	if current == nil && state.Privileges != nil {
+		current = []RolePrivilegeModel{}
+	}
+	if groups == nil && state.PrivilegeGroups != nil {
+		groups = []RolePrivilegeGroupModel{}
+	}
+	state.Privileges, state.PrivilegeGroups = current, groups
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state RoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectAddress := plan.ConnectAddress.ValueString()
+	client, err := r.client.Role(connectAddress)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get role client",
+			fmt.Sprintf("ConnectAddress: %s, error: %s", connectAddress, err.Error()),
+		)
+		return
+	}
+
+	revoke, grant := diffRolePrivileges(state.Privileges, plan.Privileges)
+	revokeGroups, grantGroups := diffRolePrivileges(state.PrivilegeGroups, plan.PrivilegeGroups)
+	err = applyRolePrivileges(client, plan.RoleName.ValueString(), revoke, grant, revokeGroups, grantGroups)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update role privileges",
+			fmt.Sprintf("ConnectAddress: %s, RoleName: %s, error: %s", connectAddress, plan.RoleName.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *RoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectAddress := state.ConnectAddress.ValueString()
+	client, err := r.client.Role(connectAddress)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get role client",
+			fmt.Sprintf("ConnectAddress: %s, error: %s", connectAddress, err.Error()),
+		)
+		return
+	}
+
+	// A role can only be dropped once all of its privileges are revoked.
+	err = applyRolePrivileges(client, state.RoleName.ValueString(), state.Privileges, nil, state.PrivilegeGroups, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to revoke role privileges",
+			fmt.Sprintf("ConnectAddress: %s, RoleName: %s, error: %s", connectAddress, state.RoleName.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	err = client.DropRole(&zilliz.DropRoleParams{RoleName: state.RoleName.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to drop role",
+			fmt.Sprintf("ConnectAddress: %s, RoleName: %s, error: %s", connectAddress, state.RoleName.ValueString(), err.Error()),
+		)
+	}
+}
+
+func (r *RoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Parse import ID, format: "/connections/{connect_address}/roles/{role_name}"
+	connectAddress, roleName, ok := ParseRoleID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Invalid import ID format",
+			"Import ID must be in the format '/connections/{connect_address}/roles/{role_name}'",
+		)
+		return
+	}
+
+	connectAddress = "https://" + connectAddress
+
+	client, err := r.client.Role(connectAddress)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get role client (import)", fmt.Sprintf("ConnectAddress: %s, error: %s", connectAddress, err))
+		return
+	}
+
+	privileges, err := client.DescribeRole(&zilliz.DescribeRoleParams{RoleName: roleName})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to import role: role does not exist or cannot be retrieved",
+			fmt.Sprintf("ConnectAddress: %s, RoleName: %s, error: %s", connectAddress, roleName, err.Error()),
+		)
+		return
+	}
+
+	// Only built-in groups can be told apart from privileges here; custom groups are picked up once configured.
+	current, groups := convertRolePrivileges(privileges, zilliz.BuiltinPrivilegeGroups)
+	state := RoleResourceModel{
+		Id:              types.StringValue(req.ID),
+		ConnectAddress:  types.StringValue(connectAddress),
+		RoleName:        types.StringValue(roleName),
+		Privileges:      current,
+		PrivilegeGroups: groups,
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,82 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/zilliztech/terraform-provider-zillizcloud/internal/provider"
+)
+
+func TestAccRoleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Step 1: Create role with one privilege
+			{
+				Config: provider.ProviderConfig + `
+resource "zillizcloud_role" "test" {
+  connect_address = "https://in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534"
+  role_name       = "testrole"
+  privileges = [
+    {
+      object_type = "Collection"
+      object_name = "*"
+      privilege   = "Search"
+    }
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zillizcloud_role.test", "role_name", "testrole"),
+					resource.TestCheckResourceAttr("zillizcloud_role.test", "privileges.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("zillizcloud_role.test", "privileges.*", map[string]string{
+						"object_type": "Collection",
+						"object_name": "*",
+						"db_name":     "default",
+						"privilege":   "Search",
+					}),
+					resource.TestCheckResourceAttrSet("zillizcloud_role.test", "id"),
+				),
+			},
+			// Step 2: Replace Search with Query in place
+			{
+				Config: provider.ProviderConfig + `
+resource "zillizcloud_role" "test" {
+  connect_address = "https://in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534"
+  role_name       = "testrole"
+  privileges = [
+    {
+      object_type = "Collection"
+      object_name = "*"
+      privilege   = "Query"
+    }
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zillizcloud_role.test", "privileges.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("zillizcloud_role.test", "privileges.*", map[string]string{
+						"privilege": "Query",
+					}),
+				),
+			},
+			// Step 3: Import role
+			{
+				ResourceName:      "zillizcloud_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					rs, ok := state.RootModule().Resources["zillizcloud_role.test"]
+					if !ok {
+						return "", fmt.Errorf("zillizcloud_role.test not found")
+					}
+					connectAddress := rs.Primary.Attributes["connect_address"]
+					connectAddress = connectAddress[len("https://"):]
+					return fmt.Sprintf("/connections/%s/roles/%s", connectAddress, rs.Primary.Attributes["role_name"]), nil
+				},
+			},
+		},
+	})
+}
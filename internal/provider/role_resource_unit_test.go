@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+)
+
+func rolePrivilege(objectType, objectName, dbName, privilege string) RolePrivilegeModel {
+	return RolePrivilegeModel{
+		ObjectType: types.StringValue(objectType),
+		ObjectName: types.StringValue(objectName),
+		DbName:     types.StringValue(dbName),
+		Privilege:  types.StringValue(privilege),
+	}
+}
+
+func TestDiffRolePrivileges(t *testing.T) {
+	search := rolePrivilege("Collection", "*", "mydb", "Search")
+	query := rolePrivilege("Collection", "*", "mydb", "Query")
+	insert := rolePrivilege("Collection", "orders", "mydb", "Insert")
+	searchOtherDb := rolePrivilege("Collection", "*", "otherdb", "Search")
+
+	revoke, grant := diffRolePrivileges(
+		[]RolePrivilegeModel{search, query},
+		[]RolePrivilegeModel{query, insert, searchOtherDb},
+	)
+
+	if len(revoke) != 1 || revoke[0].String() != search.String() {
+		t.Errorf("revoke = %v, want [%s]", revoke, search)
+	}
+	if len(grant) != 2 || grant[0].String() != insert.String() || grant[1].String() != searchOtherDb.String() {
+		t.Errorf("grant = %v, want [%s %s]", grant, insert, searchOtherDb)
+	}
+
+	revoke, grant = diffRolePrivileges([]RolePrivilegeModel{search}, []RolePrivilegeModel{search})
+	if len(revoke) != 0 || len(grant) != 0 {
+		t.Errorf("unchanged privileges produced revoke = %v, grant = %v", revoke, grant)
+	}
+}
+
+func TestApplyRolePrivilegesRevokesBeforeGranting(t *testing.T) {
+	var calls []string
+	client, err := zilliz.NewClient(
+		zilliz.WithApiKey("test-key"),
+		zilliz.WithHTTPClient(&volumeResourceMockHTTPClient{t: t, do: func(call int, req *http.Request, body []byte) (*http.Response, error) {
+			var params zilliz.RolePrivilegeParams
+			if err := json.Unmarshal(body, &params); err != nil {
+				t.Fatalf("Unmarshal request: %v", err)
+			}
+			if params.RoleName != "reader" {
+				t.Errorf("roleName = %q, want reader", params.RoleName)
+			}
+			calls = append(calls, req.URL.Path[strings.LastIndex(req.URL.Path, "/")+1:]+" "+params.Privilege)
+			return volumeJSONResponse(t, http.StatusOK, map[string]any{"code": 0, "data": map[string]any{}}), nil
+		}}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	roleClient, err := client.Role("https://in01-test.zillizcloud.com:19530")
+	if err != nil {
+		t.Fatalf("Role: %v", err)
+	}
+
+	group := func(name string) RolePrivilegeGroupModel {
+		return RolePrivilegeGroupModel{
+			PrivilegeGroup: types.StringValue(name),
+			DbName:         types.StringValue("mydb"),
+			CollectionName: types.StringValue("*"),
+		}
+	}
+	err = applyRolePrivileges(roleClient, "reader",
+		[]RolePrivilegeModel{rolePrivilege("Collection", "*", "mydb", "Insert")},
+		[]RolePrivilegeModel{rolePrivilege("Collection", "*", "mydb", "Search")},
+		[]RolePrivilegeGroupModel{group("COLL_RW")},
+		[]RolePrivilegeGroupModel{group("COLL_RO")},
+	)
+	if err != nil {
+		t.Fatalf("applyRolePrivileges: %v", err)
+	}
+	want := []string{"revoke_privilege Insert", "revoke_privilege_v2 COLL_RW", "grant_privilege Search", "grant_privilege_v2 COLL_RO"}
+	if strings.Join(calls, ",") != strings.Join(want, ",") {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+}
+
+func TestConvertRolePrivilegesSplitsGroups(t *testing.T) {
+	privileges, groups := convertRolePrivileges([]zilliz.RolePrivilege{
+		{ObjectType: "Collection", ObjectName: "*", DbName: "mydb", Privilege: "Search"},
+		{ObjectType: "Collection", ObjectName: "orders", DbName: "mydb", Privilege: "COLL_RO"},
+		{ObjectType: "Global", ObjectName: "*", DbName: "*", Privilege: "team_readers"},
+	}, rolePrivilegeGroupNames([]RolePrivilegeGroupModel{{PrivilegeGroup: types.StringValue("team_readers")}}))
+
+	if len(privileges) != 1 || privileges[0].Privilege.ValueString() != "Search" {
+		t.Errorf("privileges = %v, want [Search]", privileges)
+	}
+	if len(groups) != 2 {
+		t.Fatalf("groups = %v, want 2 groups", groups)
+	}
+	if groups[0].String() != "COLL_RO/mydb/orders" || groups[1].String() != "team_readers/*/*" {
+		t.Errorf("groups = %v, want [COLL_RO/mydb/orders team_readers/*/*]", groups)
+	}
+}
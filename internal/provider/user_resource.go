@@ -6,10 +6,14 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
@@ -18,6 +22,7 @@ import (
 var _ resource.Resource = &UserResource{}
 var _ resource.ResourceWithConfigure = &UserResource{}
 var _ resource.ResourceWithImportState = &UserResource{}
+var _ resource.ResourceWithConfigValidators = &UserResource{}
 
 func NewUserResource() resource.Resource {
 	return &UserResource{}
@@ -32,6 +37,9 @@ type UserResourceModel struct {
 	ConnectAddress types.String `tfsdk:"connect_address"`
 	Username       types.String `tfsdk:"username"`
 	Password       types.String `tfsdk:"password"`
+	// PasswordWo is write-only: it is only set in the config, never in the plan or state.
+	PasswordWo        types.String `tfsdk:"password_wo"`
+	PasswordWoVersion types.Int64  `tfsdk:"password_wo_version"`
 }
 
 func (r *UserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -43,6 +51,8 @@ func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 		MarkdownDescription: `Manages a user in a Zilliz Cloud cluster.
 
 This resource allows you to create, update, and delete users for a specific cluster using its connect address.
+Changing the password rotates it in place and keeps the roles granted to the user; changing the username or the
+connect address replaces the user.
 
 Typical use case: managing database users for access control and tenant isolation.`,
 		Attributes: map[string]schema.Attribute{
@@ -77,6 +87,9 @@ You can obtain this value from the output of the ` + "`zillizcloud_cluster`" + `
 ` + "`https://in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534`" + `
 
 > **Note:** The address must include the protocol (e.g., ` + "`https://`" + `).`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"username": schema.StringAttribute{
 				Required: true,
@@ -90,18 +103,52 @@ You can obtain this value from the output of the ` + "`zillizcloud_cluster`" + `
 - Should follow your organization's naming conventions.`,
 			},
 			"password": schema.StringAttribute{
-				Required:  true,
+				Optional:  true,
 				Sensitive: true,
 				MarkdownDescription: `The password for the user.
 
 **Best Practice:** Use the ` + "`random_password`" + ` resource to generate a strong password.
 
-> **Sensitive:** This value will not be displayed in logs or Terraform state files.`,
+Changing this value updates the password in place; role grants are preserved. The password of an imported user is not
+known, so its first change resets the password through the Zilliz Cloud control plane.
+Exactly one of ` + "`password`" + ` and ` + "`password_wo`" + ` must be set.
+
+> **Sensitive:** This value will not be displayed in logs, but it is stored in the Terraform state.
+> Use ` + "`password_wo`" + ` to keep it out of the state.`,
+			},
+			"password_wo": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				MarkdownDescription: `The password for the user, as a write-only value that is never stored in the Terraform plan or state.
+Requires Terraform 1.11 or later.
+
+Terraform cannot detect changes to a write-only value, so bump ` + "`password_wo_version`" + ` to rotate the password.
+As the current password is not known, rotation resets it in place through the Zilliz Cloud control plane; the user and
+its role grants are kept.`,
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Optional: true,
+				MarkdownDescription: `The version of ` + "`password_wo`" + `. Changing it sends the current ` + "`password_wo`" + ` value to the cluster.
+Required when ` + "`password_wo`" + ` is set.`,
 			},
 		},
 	}
 }
 
+func (r *UserResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("password"),
+			path.MatchRoot("password_wo"),
+		),
+		resourcevalidator.RequiredTogether(
+			path.MatchRoot("password_wo"),
+			path.MatchRoot("password_wo_version"),
+		),
+	}
+}
+
 func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -126,6 +173,12 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	password, diags := userPassword(ctx, req.Config, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Always set ConnectAddress as connect_address without 'https://' prefix
 	connectAddress := NormalizeConnectionID(data.ConnectAddress.ValueString())
 
@@ -148,7 +201,7 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 	for attempt := 1; attempt <= maxAttempts; attempt++ {
 		createErr = client.CreateUser(&zilliz.CreateUserParams{
 			Username: data.Username.ValueString(),
-			Password: data.Password.ValueString(),
+			Password: password,
 		})
 		if createErr == nil {
 			break
@@ -242,68 +295,55 @@ func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// 1. Get current state (old values)
 	var state UserResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...) // Old state
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// 2. Get plan (new values)
 	var plan UserResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...) // New plan
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Always set ConnectAddress as connect_address without 'https://' prefix
-	connectAddress := NormalizeConnectionID(plan.ConnectAddress.ValueString())
-
-	// 3. Get user client for old connect address
-	client, err := r.client.User(state.ConnectAddress.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to get user client",
-			fmt.Sprintf("ConnectAddress: %s, error: %s", state.ConnectAddress.ValueString(), err.Error()),
-		)
-		return
-	}
+	// connect_address and username force replacement, so only the password
+	// can change here. Rotate it in place to keep the user's role grants.
+	if !plan.Password.Equal(state.Password) || !plan.PasswordWoVersion.Equal(state.PasswordWoVersion) {
+		password, diags := userPassword(ctx, req.Config, plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 
-	// 4. Delete old user
-	err = client.DropUser(&zilliz.DropUserParams{
-		Username: state.Username.ValueString(),
-	})
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to delete user (during update)",
-			fmt.Sprintf("ConnectAddress: %s, Username: %s, error: %s", state.ConnectAddress.ValueString(), state.Username.ValueString(), err.Error()),
-		)
-		return
-	}
+		client, err := r.client.User(plan.ConnectAddress.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to get user client",
+				fmt.Sprintf("ConnectAddress: %s, error: %s", plan.ConnectAddress.ValueString(), err.Error()),
+			)
+			return
+		}
 
-	// 5. Get user client for new connect address
-	client, err = r.client.User(plan.ConnectAddress.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to get user client",
-			fmt.Sprintf("ConnectAddress: %s, error: %s", plan.ConnectAddress.ValueString(), err.Error()),
-		)
-		return
-	}
-	err = client.CreateUser(&zilliz.CreateUserParams{
-		Username: plan.Username.ValueString(),
-		Password: plan.Password.ValueString(),
-	})
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to create user (during update)",
-			fmt.Sprintf("ConnectAddress: %s, Username: %s, error: %s", plan.ConnectAddress.ValueString(), plan.Username.ValueString(), err.Error()),
-		)
-		return
+		if state.Password.IsNull() {
+			err = r.resetUserPassword(plan.ConnectAddress.ValueString(), plan.Username.ValueString(), password)
+		} else {
+			err = client.UpdatePassword(&zilliz.UpdatePasswordParams{
+				Username:    plan.Username.ValueString(),
+				Password:    state.Password.ValueString(),
+				NewPassword: password,
+			})
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to update user password",
+				fmt.Sprintf("ConnectAddress: %s, Username: %s, error: %s", plan.ConnectAddress.ValueString(), plan.Username.ValueString(), err.Error()),
+			)
+			return
+		}
 	}
 
-	// 6. Set new state
-	plan.Id = types.StringValue(BuildUserID(connectAddress, plan.Username.ValueString()))
+	plan.Id = state.Id
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...) // Update state
 }
 
@@ -348,13 +388,40 @@ func (r *UserResource) ImportState(ctx context.Context, req resource.ImportState
 		Id:             types.StringValue(req.ID),
 		ConnectAddress: types.StringValue(connectAddress),
 		Username:       types.StringValue(username),
-		Password:       types.StringValue(""), // Password is empty on import
+		Password:       types.StringNull(), // Password is not readable, so the first change resets it
 	}
 
 	// Save state to response
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+// resetUserPassword sets the password of a user whose current password is not
+// known, e.g. an imported or password_wo user, which update_password cannot
+// change. The control plane resets it in place without the old password, so
+// the user and its role grants are kept.
+func (r *UserResource) resetUserPassword(connectAddress string, username string, password string) error {
+	clusterId, ok := ClusterIdFromConnectAddress(connectAddress)
+	if !ok {
+		return fmt.Errorf("cannot determine the cluster ID from connect_address %q", connectAddress)
+	}
+	_, err := r.client.ResetClusterPassword(clusterId, &zilliz.ResetClusterPasswordParams{
+		Username: username,
+		Password: password,
+	})
+	return err
+}
+
+// userPassword returns the configured password. password_wo is write-only, so
+// it is always null in the plan and has to be read from the config.
+func userPassword(ctx context.Context, config tfsdk.Config, plan UserResourceModel) (string, diag.Diagnostics) {
+	if !plan.Password.IsNull() {
+		return plan.Password.ValueString(), nil
+	}
+	var password types.String
+	diags := config.GetAttribute(ctx, path.Root("password_wo"), &password)
+	return password.ValueString(), diags
+}
+
 func BuildUserID(connectAddress, username string) string {
 	return fmt.Sprintf("/connections/%s/users/%s", connectAddress, username)
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/zilliztech/terraform-provider-zillizcloud/internal/provider"
 )
@@ -49,6 +50,11 @@ resource "zillizcloud_user" "test" {
   password        = "NewP@ssw0rd123!"
 }
 `,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("zillizcloud_user.test", plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("zillizcloud_user.test", "connect_address", "https://in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534"),
 					resource.TestCheckResourceAttr("zillizcloud_user.test", "username", "testuser"),
@@ -74,3 +80,45 @@ resource "zillizcloud_user" "test" {
 		},
 	})
 }
+
+func TestAccUserResourceWriteOnlyPassword(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: provider.ProviderConfig + `
+resource "zillizcloud_user" "test" {
+  connect_address     = "https://in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534"
+  username            = "testuserwo"
+  password_wo         = "LZ0lS#FRU5V49$2q"
+  password_wo_version = 1
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zillizcloud_user.test", "password_wo_version", "1"),
+					resource.TestCheckNoResourceAttr("zillizcloud_user.test", "password_wo"),
+					resource.TestCheckNoResourceAttr("zillizcloud_user.test", "password"),
+				),
+			},
+			{
+				Config: provider.ProviderConfig + `
+resource "zillizcloud_user" "test" {
+  connect_address     = "https://in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534"
+  username            = "testuserwo"
+  password_wo         = "NewP@ssw0rd123!"
+  password_wo_version = 2
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("zillizcloud_user.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zillizcloud_user.test", "password_wo_version", "2"),
+					resource.TestCheckNoResourceAttr("zillizcloud_user.test", "password_wo"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,246 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+)
+
+func TestUserResourceUpdateRotatesPasswordInPlace(t *testing.T) {
+	ctx := context.Background()
+	var paths []string
+	client, err := zilliz.NewClient(
+		zilliz.WithApiKey("test-key"),
+		zilliz.WithHTTPClient(&volumeResourceMockHTTPClient{t: t, do: func(call int, req *http.Request, body []byte) (*http.Response, error) {
+			paths = append(paths, req.URL.Path)
+			var params zilliz.UpdatePasswordParams
+			if err := json.Unmarshal(body, &params); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+			if params.Username != "alice" || params.Password != "old-Passw0rd" || params.NewPassword != "new-Passw0rd" {
+				t.Errorf("params = %+v", params)
+			}
+			return volumeJSONResponse(t, http.StatusOK, map[string]any{"code": 0, "data": map[string]any{}}), nil
+		}}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	r := &UserResource{client: client}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	model := UserResourceModel{
+		Id:             types.StringValue("/connections/in01-test.zillizcloud.com:19530/users/alice"),
+		ConnectAddress: types.StringValue("https://in01-test.zillizcloud.com:19530"),
+		Username:       types.StringValue("alice"),
+		Password:       types.StringValue("old-Passw0rd"),
+	}
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := state.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("State.Set: %v", diags)
+	}
+	model.Password = types.StringValue("new-Passw0rd")
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	if diags := plan.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("Plan.Set: %v", diags)
+	}
+
+	resp := fwresource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Update(ctx, fwresource.UpdateRequest{State: state, Plan: plan}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update: %v", resp.Diagnostics)
+	}
+	if len(paths) != 1 || paths[0] != "/v2/vectordb/users/update_password" {
+		t.Fatalf("requests = %v, want a single update_password call", paths)
+	}
+
+	var got UserResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if got.Password.ValueString() != "new-Passw0rd" || got.Id.ValueString() != model.Id.ValueString() {
+		t.Errorf("state = %+v", got)
+	}
+}
+
+func TestUserResourceUpdateRotatesWriteOnlyPassword(t *testing.T) {
+	ctx := context.Background()
+	var paths []string
+	var got zilliz.ResetClusterPasswordParams
+	client, err := zilliz.NewClient(
+		zilliz.WithApiKey("test-key"),
+		zilliz.WithHTTPClient(&volumeResourceMockHTTPClient{t: t, do: func(call int, req *http.Request, body []byte) (*http.Response, error) {
+			paths = append(paths, req.URL.Path)
+			if req.URL.Path != "/v2/clusters/in01-test/resetPassword" {
+				t.Fatalf("unexpected request %s", req.URL.Path)
+			}
+			if err := json.Unmarshal(body, &got); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+			return volumeJSONResponse(t, http.StatusOK, map[string]any{"code": 0, "data": map[string]any{"clusterId": "in01-test"}}), nil
+		}}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	r := &UserResource{client: client}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	model := UserResourceModel{
+		Id:                types.StringValue("/connections/in01-test.zillizcloud.com:19530/users/alice"),
+		ConnectAddress:    types.StringValue("https://in01-test.zillizcloud.com:19530"),
+		Username:          types.StringValue("alice"),
+		Password:          types.StringNull(),
+		PasswordWo:        types.StringNull(),
+		PasswordWoVersion: types.Int64Value(1),
+	}
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := state.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("State.Set: %v", diags)
+	}
+	model.PasswordWoVersion = types.Int64Value(2)
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	if diags := plan.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("Plan.Set: %v", diags)
+	}
+	model.PasswordWo = types.StringValue("rotated-Passw0rd")
+	raw := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := raw.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("Set config: %v", diags)
+	}
+	config := tfsdk.Config{Schema: schemaResp.Schema, Raw: raw.Raw}
+
+	resp := fwresource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Update(ctx, fwresource.UpdateRequest{State: state, Plan: plan, Config: config}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update: %v", resp.Diagnostics)
+	}
+	if len(paths) != 1 {
+		t.Fatalf("requests = %v, want a single resetPassword call", paths)
+	}
+	if got.Username != "alice" || got.Password != "rotated-Passw0rd" {
+		t.Errorf("resetPassword params = %+v", got)
+	}
+
+	var updated UserResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &updated)...)
+	if !updated.PasswordWo.IsNull() || updated.PasswordWoVersion.ValueInt64() != 2 {
+		t.Errorf("state = %+v, want no password_wo and version 2", updated)
+	}
+}
+
+func TestUserResourceWriteOnlyRotationNeverDropsUser(t *testing.T) {
+	ctx := context.Background()
+	client, err := zilliz.NewClient(
+		zilliz.WithApiKey("test-key"),
+		zilliz.WithHTTPClient(&volumeResourceMockHTTPClient{t: t, do: func(call int, req *http.Request, body []byte) (*http.Response, error) {
+			switch req.URL.Path {
+			case "/v2/vectordb/users/drop", "/v2/vectordb/users/create":
+				t.Fatalf("rotation called %s", req.URL.Path)
+			}
+			return volumeJSONResponse(t, http.StatusOK, map[string]any{"code": 0, "data": map[string]any{"clusterId": "in01-test"}}), nil
+		}}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	r := &UserResource{client: client}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	model := UserResourceModel{
+		Id:                types.StringValue("/connections/in01-test.zillizcloud.com:19530/users/alice"),
+		ConnectAddress:    types.StringValue("https://in01-test.zillizcloud.com:19530"),
+		Username:          types.StringValue("alice"),
+		Password:          types.StringNull(),
+		PasswordWo:        types.StringNull(),
+		PasswordWoVersion: types.Int64Value(1),
+	}
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := state.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("State.Set: %v", diags)
+	}
+
+	// Every version bump rotates the password, none of them may drop the user.
+	for version := int64(2); version <= 3; version++ {
+		model.PasswordWo = types.StringNull()
+		model.PasswordWoVersion = types.Int64Value(version)
+		plan := tfsdk.Plan{Schema: schemaResp.Schema}
+		if diags := plan.Set(ctx, &model); diags.HasError() {
+			t.Fatalf("Plan.Set: %v", diags)
+		}
+		model.PasswordWo = types.StringValue(fmt.Sprintf("rotated-Passw0rd-%d", version))
+		raw := tfsdk.State{Schema: schemaResp.Schema}
+		if diags := raw.Set(ctx, &model); diags.HasError() {
+			t.Fatalf("Set config: %v", diags)
+		}
+
+		resp := fwresource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+		r.Update(ctx, fwresource.UpdateRequest{State: state, Plan: plan, Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw.Raw}}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Update to version %d: %v", version, resp.Diagnostics)
+		}
+		state = resp.State
+	}
+}
+
+func TestUserResourceUpdateResetsImportedUserPassword(t *testing.T) {
+	ctx := context.Background()
+	var paths []string
+	client, err := zilliz.NewClient(
+		zilliz.WithApiKey("test-key"),
+		zilliz.WithHTTPClient(&volumeResourceMockHTTPClient{t: t, do: func(call int, req *http.Request, body []byte) (*http.Response, error) {
+			paths = append(paths, req.URL.Path)
+			return volumeJSONResponse(t, http.StatusOK, map[string]any{"code": 0, "data": map[string]any{"clusterId": "in01-test"}}), nil
+		}}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	r := &UserResource{client: client}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	// An imported user has no password in state.
+	model := UserResourceModel{
+		Id:             types.StringValue("/connections/in01-test.zillizcloud.com:19530/users/alice"),
+		ConnectAddress: types.StringValue("https://in01-test.zillizcloud.com:19530"),
+		Username:       types.StringValue("alice"),
+		Password:       types.StringNull(),
+	}
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := state.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("State.Set: %v", diags)
+	}
+	model.Password = types.StringValue("new-Passw0rd")
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	if diags := plan.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("Plan.Set: %v", diags)
+	}
+
+	resp := fwresource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Update(ctx, fwresource.UpdateRequest{State: state, Plan: plan}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update: %v", resp.Diagnostics)
+	}
+	if len(paths) != 1 || paths[0] != "/v2/clusters/in01-test/resetPassword" {
+		t.Fatalf("requests = %v, want the password to be reset in place", paths)
+	}
+}
+
+func TestClusterIdFromConnectAddress(t *testing.T) {
+	id, ok := ClusterIdFromConnectAddress("https://in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534")
+	if !ok || id != "in01-295cd02566647b7" {
+		t.Fatalf("ClusterIdFromConnectAddress = %q, %v", id, ok)
+	}
+	if _, ok := ClusterIdFromConnectAddress("https://localhost:19530"); ok {
+		t.Fatal("expected an address without a cluster host to be rejected")
+	}
+}
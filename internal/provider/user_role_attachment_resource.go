@@ -0,0 +1,269 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
+)
+
+var _ resource.Resource = &UserRoleAttachmentResource{}
+var _ resource.ResourceWithConfigure = &UserRoleAttachmentResource{}
+var _ resource.ResourceWithImportState = &UserRoleAttachmentResource{}
+
+func NewUserRoleAttachmentResource() resource.Resource {
+	return &UserRoleAttachmentResource{}
+}
+
+type UserRoleAttachmentResource struct {
+	client *zilliz.Client
+}
+
+type UserRoleAttachmentResourceModel struct {
+	Id             types.String `tfsdk:"id"` // /connections/{connect_address}/users/{username}/roles/{role_name}
+	ConnectAddress types.String `tfsdk:"connect_address"`
+	Username       types.String `tfsdk:"username"`
+	RoleName       types.String `tfsdk:"role_name"`
+}
+
+func (r *UserRoleAttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_role_attachment"
+}
+
+func (r *UserRoleAttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Grants a single role to a user in a Zilliz Cloud cluster.
+
+Unlike ` + "`zillizcloud_user_role`" + `, this resource is not authoritative: it only manages its own (user, role) pair
+and leaves other roles of the user untouched, so several modules can grant roles to the same user.
+Do not combine it with ` + "`zillizcloud_user_role`" + ` for the same user.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: `The unique identifier for the attachment.
+
+**Format:**
+` + "`" + `/connections/{connect_address}/users/{username}/roles/{role_name}` + "`" + `
+
+> **Note:** This value is automatically set and should not be manually specified.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connect_address": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: `The connection address of the target Zilliz Cloud cluster.
+You can obtain this value from the output of the ` + "`zillizcloud_cluster`" + ` resource, for example:
+` + "`zillizcloud_cluster.example.connect_address`" + `
+
+**Example:**
+` + "`https://in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534`" + `
+
+> **Note:** The address must include the protocol (e.g., ` + "`https://`" + `).`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: `The username of the database user the role is granted to.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: `The name of the role to grant.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *UserRoleAttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*zilliz.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected provider type",
+			fmt.Sprintf("Expected *zilliz.Client, got: %T. Please check provider configuration.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// BuildUserRoleAttachmentID returns the RESTful ID for a user role attachment.
+func BuildUserRoleAttachmentID(connectAddress, username, roleName string) string {
+	return fmt.Sprintf("/connections/%s/users/%s/roles/%s", connectAddress, username, roleName)
+}
+
+// ParseUserRoleAttachmentID parses the RESTful ID and returns connectAddress, username and roleName.
+func ParseUserRoleAttachmentID(id string) (connectAddress, username, roleName string, ok bool) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 7 || parts[1] != "connections" || parts[3] != "users" || parts[5] != "roles" {
+		return "", "", "", false
+	}
+	return parts[2], parts[4], parts[6], true
+}
+
+func (r *UserRoleAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserRoleAttachmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectAddress := data.ConnectAddress.ValueString()
+	client, err := r.client.User(connectAddress)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get user client",
+			fmt.Sprintf("ConnectAddress: %s, error: %s", connectAddress, err.Error()),
+		)
+		return
+	}
+
+	err = client.GrantRoleToUser(&zilliz.UserGrantRoleToUserParams{
+		UserName: data.Username.ValueString(),
+		RoleName: data.RoleName.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to grant role to user",
+			fmt.Sprintf("ConnectAddress: %s, Username: %s, Role: %s, error: %s", connectAddress, data.Username.ValueString(), data.RoleName.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	data.Id = types.StringValue(BuildUserRoleAttachmentID(NormalizeConnectionID(connectAddress), data.Username.ValueString(), data.RoleName.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserRoleAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state UserRoleAttachmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectAddress := state.ConnectAddress.ValueString()
+	client, err := r.client.User(connectAddress)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get user client",
+			fmt.Sprintf("ConnectAddress: %s, error: %s", connectAddress, err.Error()),
+		)
+		return
+	}
+
+	roles, err := client.DescribeUser(&zilliz.DescribeUserParams{
+		Username: state.Username.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read user info",
+			fmt.Sprintf("ConnectAddress: %s, Username: %s, error: %s", connectAddress, state.Username.ValueString(), err.Error()),
+		)
+		return
+	}
+	// The role was revoked outside Terraform; granting it again is a new attachment.
+	if !slices.Contains(roles, state.RoleName.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called: every attribute forces replacement.
+func (r *UserRoleAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan UserRoleAttachmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *UserRoleAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state UserRoleAttachmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectAddress := state.ConnectAddress.ValueString()
+	client, err := r.client.User(connectAddress)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get user client",
+			fmt.Sprintf("ConnectAddress: %s, error: %s", connectAddress, err.Error()),
+		)
+		return
+	}
+
+	err = client.RevokeRoleFromUser(&zilliz.UserRevokeRoleFromParams{
+		UserName: state.Username.ValueString(),
+		RoleName: state.RoleName.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to revoke role from user",
+			fmt.Sprintf("ConnectAddress: %s, Username: %s, Role: %s, error: %s", connectAddress, state.Username.ValueString(), state.RoleName.ValueString(), err.Error()),
+		)
+	}
+}
+
+func (r *UserRoleAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Parse import ID, format: "/connections/{connect_address}/users/{username}/roles/{role_name}"
+	connectAddress, username, roleName, ok := ParseUserRoleAttachmentID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Invalid import ID format",
+			"Import ID must be in the format '/connections/{connect_address}/users/{username}/roles/{role_name}'",
+		)
+		return
+	}
+
+	connectAddress = "https://" + connectAddress
+
+	client, err := r.client.User(connectAddress)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get user client (import)", fmt.Sprintf("ConnectAddress: %s, error: %s", connectAddress, err))
+		return
+	}
+
+	roles, err := client.DescribeUser(&zilliz.DescribeUserParams{
+		Username: username,
+	})
+	if err == nil && !slices.Contains(roles, roleName) {
+		err = fmt.Errorf("role %s is not granted to user %s", roleName, username)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to import user role attachment",
+			fmt.Sprintf("ConnectAddress: %s, Username: %s, Role: %s, error: %s", connectAddress, username, roleName, err.Error()),
+		)
+		return
+	}
+
+	state := UserRoleAttachmentResourceModel{
+		Id:             types.StringValue(req.ID),
+		ConnectAddress: types.StringValue(connectAddress),
+		Username:       types.StringValue(username),
+		RoleName:       types.StringValue(roleName),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,58 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/zilliztech/terraform-provider-zillizcloud/internal/provider"
+)
+
+func TestAccUserRoleAttachmentResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: provider.ProviderConfig + `
+resource "zillizcloud_user" "test" {
+  connect_address = "https://in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534"
+  username        = "testuser"
+  password        = "LZ0lS#FRU5V49$2q"
+}
+
+resource "zillizcloud_user_role_attachment" "ro" {
+  connect_address = zillizcloud_user.test.connect_address
+  username        = zillizcloud_user.test.username
+  role_name       = "db_ro"
+}
+
+resource "zillizcloud_user_role_attachment" "rw" {
+  connect_address = zillizcloud_user.test.connect_address
+  username        = zillizcloud_user.test.username
+  role_name       = "db_rw"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("zillizcloud_user_role_attachment.ro", "username", "testuser"),
+					resource.TestCheckResourceAttr("zillizcloud_user_role_attachment.ro", "role_name", "db_ro"),
+					resource.TestCheckResourceAttr("zillizcloud_user_role_attachment.rw", "role_name", "db_rw"),
+					resource.TestCheckResourceAttr("zillizcloud_user_role_attachment.ro", "id",
+						"/connections/in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534/users/testuser/roles/db_ro"),
+				),
+			},
+			{
+				ResourceName:      "zillizcloud_user_role_attachment.ro",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					rs, ok := state.RootModule().Resources["zillizcloud_user_role_attachment.ro"]
+					if !ok {
+						return "", fmt.Errorf("zillizcloud_user_role_attachment.ro not found")
+					}
+					return rs.Primary.ID, nil
+				},
+			},
+		},
+	})
+}
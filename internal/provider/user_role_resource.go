@@ -7,8 +7,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	zilliz "github.com/zilliztech/terraform-provider-zillizcloud/client"
@@ -17,6 +17,7 @@ import (
 var _ resource.Resource = &UserRoleResource{}
 var _ resource.ResourceWithConfigure = &UserRoleResource{}
 var _ resource.ResourceWithImportState = &UserRoleResource{}
+var _ resource.ResourceWithUpgradeState = &UserRoleResource{}
 
 func NewUserRoleResource() resource.Resource {
 	return &UserRoleResource{}
@@ -39,9 +40,12 @@ func (r *UserRoleResource) Metadata(ctx context.Context, req resource.MetadataRe
 
 func (r *UserRoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		MarkdownDescription: `Manages the roles assigned to a user in a Zilliz Cloud cluster.
 
 This resource allows you to grant or revoke one or more roles for a specific user within a cluster using its connect address.
+It is authoritative: roles granted to the user outside this resource are revoked. To manage a single role alongside
+other modules, use ` + "`zillizcloud_user_role_attachment`" + ` instead.
 
 Typical use case: manage user permissions and access control for database security and multi-tenant environments.`,
 		Attributes: map[string]schema.Attribute{
@@ -88,23 +92,47 @@ You can obtain this value from the output of the ` + "`zillizcloud_cluster`" + `
 - Must be an existing user in the cluster.
 - Should follow your organization's naming conventions.`,
 			},
-			"roles": schema.ListAttribute{
+			"roles": schema.SetAttribute{
 				ElementType: types.StringType,
 				Required:    true,
-				MarkdownDescription: `A list of roles to assign to the user.
+				MarkdownDescription: `A set of roles to assign to the user.
 
 **Example:**  
 ` + "`" + `["db_admin", "read_only"]` + "`" + `
 
 > **Note:** Only valid roles supported by the cluster can be assigned.`,
-				PlanModifiers: []planmodifier.List{
-					listplanmodifier.UseStateForUnknown(),
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
 				},
 			},
 		},
 	}
 }
 
+// UpgradeState converts state written while roles was a list. The element values are unchanged.
+func (r *UserRoleResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":              schema.StringAttribute{Computed: true},
+					"connect_address": schema.StringAttribute{Required: true},
+					"username":        schema.StringAttribute{Required: true},
+					"roles":           schema.ListAttribute{ElementType: types.StringType, Required: true},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior UserRoleResourceModel
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				resp.Diagnostics.Append(resp.State.Set(ctx, &prior)...)
+			},
+		},
+	}
+}
+
 func (r *UserRoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
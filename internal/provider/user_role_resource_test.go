@@ -30,8 +30,8 @@ resource "zillizcloud_user_role" "test" {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("zillizcloud_user_role.test", "connect_address", "https://in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534"),
 					resource.TestCheckResourceAttr("zillizcloud_user_role.test", "username", "testuser"),
-					resource.TestCheckResourceAttr("zillizcloud_user_role.test", "roles.0", "db_ro"),
-					resource.TestCheckResourceAttr("zillizcloud_user_role.test", "roles.1", "db_rw"),
+					resource.TestCheckTypeSetElemAttr("zillizcloud_user_role.test", "roles.*", "db_ro"),
+					resource.TestCheckTypeSetElemAttr("zillizcloud_user_role.test", "roles.*", "db_rw"),
 					resource.TestCheckResourceAttrSet("zillizcloud_user_role.test", "id"),
 				),
 			},
@@ -67,7 +67,7 @@ resource "zillizcloud_user_role" "test" {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("zillizcloud_user_role.test", "connect_address", "https://in01-295cd02566647b7.aws-us-east-2.vectordb.zillizcloud.com:19534"),
 					resource.TestCheckResourceAttr("zillizcloud_user_role.test", "username", "testuser"),
-					resource.TestCheckResourceAttr("zillizcloud_user_role.test", "roles.0", "db_ro"),
+					resource.TestCheckTypeSetElemAttr("zillizcloud_user_role.test", "roles.*", "db_ro"),
 					resource.TestCheckResourceAttr("zillizcloud_user_role.test", "roles.#", "1"),
 					resource.TestCheckResourceAttrSet("zillizcloud_user_role.test", "id"),
 				),
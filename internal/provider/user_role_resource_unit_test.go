@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUserRoleResourceUpgradeStateFromList(t *testing.T) {
+	ctx := context.Background()
+	r := &UserRoleResource{}
+	upgrader, ok := r.UpgradeState(ctx)[0]
+	if !ok {
+		t.Fatalf("no state upgrader for version 0")
+	}
+
+	priorType := upgrader.PriorSchema.Type().TerraformType(ctx)
+	prior := tftypes.NewValue(priorType, map[string]tftypes.Value{
+		"id":              tftypes.NewValue(tftypes.String, "/connections/in01-test/users/alice/roles"),
+		"connect_address": tftypes.NewValue(tftypes.String, "https://in01-test"),
+		"username":        tftypes.NewValue(tftypes.String, "alice"),
+		"roles": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "db_rw"),
+			tftypes.NewValue(tftypes.String, "db_ro"),
+		}),
+	})
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	req := resource.UpgradeStateRequest{State: &tfsdk.State{Schema: *upgrader.PriorSchema, Raw: prior}}
+	resp := resource.UpgradeStateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	upgrader.StateUpgrader(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("StateUpgrader: %v", resp.Diagnostics)
+	}
+
+	var upgraded UserRoleResourceModel
+	if diags := resp.State.Get(ctx, &upgraded); diags.HasError() {
+		t.Fatalf("Get upgraded state: %v", diags)
+	}
+	roles := privilegeStrings(upgraded.Roles)
+	slices.Sort(roles)
+	if !slices.Equal(roles, []string{"db_ro", "db_rw"}) {
+		t.Errorf("roles = %v, want [db_ro db_rw]", roles)
+	}
+	if upgraded.Username.ValueString() != "alice" {
+		t.Errorf("username = %s, want alice", upgraded.Username)
+	}
+}
+
+func TestParseUserRoleAttachmentID(t *testing.T) {
+	connectAddress, username, roleName, ok := ParseUserRoleAttachmentID("/connections/in01-test.zillizcloud.com:19530/users/alice/roles/db_ro")
+	if !ok || connectAddress != "in01-test.zillizcloud.com:19530" || username != "alice" || roleName != "db_ro" {
+		t.Errorf("ParseUserRoleAttachmentID = %q, %q, %q, %v", connectAddress, username, roleName, ok)
+	}
+	if _, _, _, ok := ParseUserRoleAttachmentID("/connections/in01-test/users/alice/roles"); ok {
+		t.Errorf("ParseUserRoleAttachmentID accepted a zillizcloud_user_role ID")
+	}
+}
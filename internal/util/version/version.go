@@ -0,0 +1,79 @@
+package version
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Compare compares two Milvus versions such as 2.5.4 or v2.4.17-hotfix by
+// their numeric components, ignoring a leading v and any suffix. ok is false
+// when either version cannot be parsed.
+func Compare(a, b string) (cmp int, ok bool) {
+	pa, okA := parse(a)
+	pb, okB := parse(b)
+	if !okA || !okB {
+		return 0, false
+	}
+	for i := 0; i < len(pa) || i < len(pb); i++ {
+		var x, y int
+		if i < len(pa) {
+			x = pa[i]
+		}
+		if i < len(pb) {
+			y = pb[i]
+		}
+		if x != y {
+			if x < y {
+				return -1, true
+			}
+			return 1, true
+		}
+	}
+	return 0, true
+}
+
+// Same reports whether two versions are equal, comparing them as strings when
+// either cannot be parsed.
+func Same(a, b string) bool {
+	if cmp, ok := Compare(a, b); ok {
+		return cmp == 0
+	}
+	return a == b
+}
+
+// Sort sorts versions in ascending order. Versions that cannot be parsed are
+// sorted after the others, by their string value.
+func Sort(versions []string) {
+	sort.SliceStable(versions, func(i, j int) bool {
+		if cmp, ok := Compare(versions[i], versions[j]); ok {
+			return cmp < 0
+		}
+		_, okI := parse(versions[i])
+		_, okJ := parse(versions[j])
+		if okI != okJ {
+			return okI
+		}
+		return versions[i] < versions[j]
+	})
+}
+
+func parse(v string) ([]int, bool) {
+	v = strings.TrimPrefix(strings.TrimSpace(v), "v")
+	if i := strings.IndexAny(v, "-+"); i >= 0 {
+		v = v[:i]
+	}
+	if v == "" {
+		return nil, false
+	}
+	parts := strings.Split(v, ".")
+	ret := make([]int, len(parts))
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return nil, false
+		}
+		ret[i] = n
+	}
+	return ret, true
+}
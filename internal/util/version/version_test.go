@@ -0,0 +1,41 @@
+package version
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b   string
+		want   int
+		wantOk bool
+	}{
+		{a: "2.5.4", b: "2.5.4", want: 0, wantOk: true},
+		{a: "v2.5.4", b: "2.5.4", want: 0, wantOk: true},
+		{a: "2.5", b: "2.5.0", want: 0, wantOk: true},
+		{a: "2.4.17", b: "2.5.4", want: -1, wantOk: true},
+		{a: "2.5.10", b: "2.5.9", want: 1, wantOk: true},
+		{a: "2.5.4-hotfix.1", b: "2.5.4", want: 0, wantOk: true},
+		{a: "latest", b: "2.5.4"},
+		{a: "", b: "2.5.4"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"_"+tt.b, func(t *testing.T) {
+			got, ok := Compare(tt.a, tt.b)
+			if got != tt.want || ok != tt.wantOk {
+				t.Fatalf("Compare(%q, %q) = %d, %t, want %d, %t", tt.a, tt.b, got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestSort(t *testing.T) {
+	versions := []string{"2.5.10", "latest", "2.4.17", "2.5.9", "v2.5.4"}
+	Sort(versions)
+	want := []string{"2.4.17", "v2.5.4", "2.5.9", "2.5.10", "latest"}
+	if !slices.Equal(versions, want) {
+		t.Fatalf("Sort = %v, want %v", versions, want)
+	}
+}
@@ -0,0 +1,40 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// CIDRValidator validates that a string is a CIDR block whose address is the
+// network address, such as 203.0.113.0/24. The API stores blocks in that
+// form, so a block with host bits set would show up as drift.
+type CIDRValidator struct{}
+
+func (v CIDRValidator) Description(_ context.Context) string {
+	return "Must be a CIDR block such as 203.0.113.0/24, use a /32 block for a single address."
+}
+
+func (v CIDRValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v CIDRValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	ip, network, err := net.ParseCIDR(value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid CIDR block",
+			fmt.Sprintf("%q is not a CIDR block, use a value such as 203.0.113.0/24, or 203.0.113.7/32 for a single address.", value))
+		return
+	}
+	if !ip.Equal(network.IP) {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid CIDR block",
+			fmt.Sprintf("%q has host bits set, use %s instead.", value, network.String()))
+	}
+}
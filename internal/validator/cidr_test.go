@@ -0,0 +1,36 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCIDRValidator(t *testing.T) {
+	tests := []struct {
+		cidr    string
+		wantErr string
+	}{
+		{cidr: "203.0.113.0/24"},
+		{cidr: "203.0.113.7/32"},
+		{cidr: "0.0.0.0/0"},
+		{cidr: "2001:db8::/32"},
+		{cidr: "203.0.113.7", wantErr: "is not a CIDR block"},
+		{cidr: "203.0.113.0/33", wantErr: "is not a CIDR block"},
+		{cidr: "203.0.113.7/24", wantErr: "use 203.0.113.0/24 instead"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.cidr, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			CIDRValidator{}.ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("cidr"),
+				ConfigValue: types.StringValue(tt.cidr),
+			}, resp)
+			assertSingleDiagnostic(t, resp.Diagnostics.Errors(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package validator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	// Embed the IANA database so timezone checks do not depend on the host.
+	_ "time/tzdata"
+)
+
+// cronField describes one field of the 5-field cron dialect accepted by the
+// autoscaling API: minute hour day-of-month month day-of-week.
+type cronField struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day-of-month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}},
+	// 7 is accepted as an alias of Sunday.
+	{name: "day-of-week", min: 0, max: 7, names: map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}},
+}
+
+// cronSearchHorizon bounds how far ahead fire times are searched. Eight years
+// always include a leap year, so "0 0 29 2 *" is still found.
+const cronSearchHorizon = 8 * 366 * 24 * time.Hour
+
+// CronSchedule is a parsed cron expression. Each field is a bitset of the
+// values it matches.
+type CronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	// domRestricted and dowRestricted follow the cron rule that when both day
+	// fields are restricted, a day matches if either of them does.
+	domRestricted, dowRestricted bool
+}
+
+// ParseCron parses a standard 5-field cron expression. Fields support *,
+// single values, ranges (1-5), lists (1,3,5), steps (*/15, 0-30/10) and the
+// three-letter month and weekday names.
+func ParseCron(expr string) (*CronSchedule, error) {
+	parts := strings.Fields(expr)
+	if len(parts) != len(cronFields) {
+		return nil, fmt.Errorf("expected 5 fields (minute hour day-of-month month day-of-week), got %d", len(parts))
+	}
+
+	bits := make([]uint64, len(parts))
+	for i, part := range parts {
+		b, err := parseCronField(part, cronFields[i])
+		if err != nil {
+			return nil, err
+		}
+		bits[i] = b
+	}
+
+	s := &CronSchedule{
+		minute:        bits[0],
+		hour:          bits[1],
+		dom:           bits[2],
+		month:         bits[3],
+		dow:           bits[4],
+		domRestricted: parts[2] != "*",
+		dowRestricted: parts[4] != "*",
+	}
+	// Fold Sunday=7 onto Sunday=0.
+	if s.dow&(1<<7) != 0 {
+		s.dow = s.dow&^(1<<7) | 1
+	}
+	return s, nil
+}
+
+func parseCronField(expr string, field cronField) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(expr, ",") {
+		if item == "" {
+			return 0, fmt.Errorf("%s: empty list item in %q", field.name, expr)
+		}
+
+		rangeExpr, step := item, 1
+		if i := strings.Index(item, "/"); i >= 0 {
+			rangeExpr = item[:i]
+			n, err := strconv.Atoi(item[i+1:])
+			if err != nil || n < 1 {
+				return 0, fmt.Errorf("%s: invalid step in %q", field.name, item)
+			}
+			step = n
+		}
+
+		var lo, hi int
+		switch {
+		case rangeExpr == "*":
+			lo, hi = field.min, field.max
+		case strings.Contains(rangeExpr, "-"):
+			bounds := strings.SplitN(rangeExpr, "-", 2)
+			var err error
+			if lo, err = parseCronValue(bounds[0], field); err != nil {
+				return 0, err
+			}
+			if hi, err = parseCronValue(bounds[1], field); err != nil {
+				return 0, err
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("%s: range %q is reversed", field.name, rangeExpr)
+			}
+		default:
+			v, err := parseCronValue(rangeExpr, field)
+			if err != nil {
+				return 0, err
+			}
+			lo, hi = v, v
+			// "5/10" means every 10 starting at 5.
+			if step > 1 {
+				hi = field.max
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+func parseCronValue(s string, field cronField) (int, error) {
+	if v, ok := field.names[strings.ToUpper(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("%s: invalid value %q", field.name, s)
+	}
+	if v < field.min || v > field.max {
+		return 0, fmt.Errorf("%s: value %d out of range %d-%d", field.name, v, field.min, field.max)
+	}
+	return v, nil
+}
+
+func (s *CronSchedule) matchesDay(t time.Time) bool {
+	if s.month&(1<<uint(t.Month())) == 0 {
+		return false
+	}
+	dom := s.dom&(1<<uint(t.Day())) != 0
+	dow := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domRestricted && s.dowRestricted {
+		return dom || dow
+	}
+	return dom && dow
+}
+
+// Matches reports whether the schedule fires at the wall-clock minute of t.
+func (s *CronSchedule) Matches(t time.Time) bool {
+	return s.matchesDay(t) &&
+		s.hour&(1<<uint(t.Hour())) != 0 &&
+		s.minute&(1<<uint(t.Minute())) != 0
+}
+
+// Next returns the first fire time strictly after t, evaluated on the wall
+// clock of loc. Wall-clock minutes skipped by a DST transition never fire,
+// and minutes repeated by one fire on each occurrence. The zero time is
+// returned when the schedule does not fire within the search horizon.
+func (s *CronSchedule) Next(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc).Truncate(time.Minute).Add(time.Minute)
+	end := t.Add(cronSearchHorizon)
+
+	for t.Before(end) {
+		if !s.matchesDay(t) {
+			next := time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			if !next.After(t) {
+				next = t.Add(time.Minute)
+			}
+			t = next
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = t.Add(time.Duration(60-t.Minute()) * time.Minute)
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// FirstCommonFireTime returns the first instant after from at which both
+// schedules fire, each evaluated in its own location, searching up to the
+// given horizon. ok is false when they never coincide within it.
+func FirstCommonFireTime(a *CronSchedule, aLoc *time.Location, b *CronSchedule, bLoc *time.Location, from time.Time, horizon time.Duration) (time.Time, bool) {
+	end := from.Add(horizon)
+	for t := a.Next(from, aLoc); !t.IsZero() && t.Before(end); t = a.Next(t, aLoc) {
+		if b.Matches(t.In(bLoc)) {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,184 @@
+package validator
+
+import (
+	"testing"
+	"time"
+)
+
+func mustLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("LoadLocation(%q): %v", name, err)
+	}
+	return loc
+}
+
+func mustCron(t *testing.T, expr string) *CronSchedule {
+	t.Helper()
+	s, err := ParseCron(expr)
+	if err != nil {
+		t.Fatalf("ParseCron(%q): %v", expr, err)
+	}
+	return s
+}
+
+func TestParseCron(t *testing.T) {
+	valid := []string{
+		"0 9 * * 1-5",
+		"*/15 * * * *",
+		"0-30/10 8,12,18 * * *",
+		"5/20 * * * *",
+		"0 0 1 JAN,jul *",
+		"0 6 * * MON-FRI",
+		"0 0 * * 7",
+		"  0   0  *  *  0 ",
+	}
+	for _, expr := range valid {
+		if _, err := ParseCron(expr); err != nil {
+			t.Errorf("ParseCron(%q) error: %v", expr, err)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"0 9 * *",
+		"0 9 * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"1,,2 * * * *",
+		"@daily * * * *",
+		"0 9 * * MOND",
+	}
+	for _, expr := range invalid {
+		if _, err := ParseCron(expr); err == nil {
+			t.Errorf("ParseCron(%q) should fail", expr)
+		}
+	}
+}
+
+func TestCronScheduleNext(t *testing.T) {
+	utc := time.UTC
+	newYork := mustLocation(t, "America/New_York")
+
+	tests := []struct {
+		name string
+		expr string
+		loc  *time.Location
+		from time.Time
+		want []time.Time
+	}{
+		{
+			name: "weekdays skip the weekend",
+			expr: "0 9 * * 1-5",
+			loc:  utc,
+			from: time.Date(2026, 10, 16, 10, 0, 0, 0, utc), // Friday
+			want: []time.Time{
+				time.Date(2026, 10, 19, 9, 0, 0, 0, utc),
+				time.Date(2026, 10, 20, 9, 0, 0, 0, utc),
+			},
+		},
+		{
+			name: "Sunday as 7",
+			expr: "0 0 * * 7",
+			loc:  utc,
+			from: time.Date(2026, 10, 16, 0, 0, 0, 0, utc),
+			want: []time.Time{time.Date(2026, 10, 18, 0, 0, 0, 0, utc)},
+		},
+		{
+			name: "restricted day-of-month and day-of-week match either",
+			expr: "0 0 1 * 1",
+			loc:  utc,
+			from: time.Date(2026, 10, 27, 0, 0, 0, 0, utc), // Tuesday
+			want: []time.Time{
+				time.Date(2026, 11, 1, 0, 0, 0, 0, utc), // 1st, a Sunday
+				time.Date(2026, 11, 2, 0, 0, 0, 0, utc), // Monday
+			},
+		},
+		{
+			name: "leap day",
+			expr: "0 0 29 2 *",
+			loc:  utc,
+			from: time.Date(2026, 1, 1, 0, 0, 0, 0, utc),
+			want: []time.Time{time.Date(2028, 2, 29, 0, 0, 0, 0, utc)},
+		},
+		{
+			name: "skipped by spring forward",
+			expr: "30 2 * * *",
+			loc:  newYork,
+			from: time.Date(2026, 3, 7, 12, 0, 0, 0, newYork),
+			// 2026-03-08 02:30 does not exist in New York.
+			want: []time.Time{
+				time.Date(2026, 3, 9, 2, 30, 0, 0, newYork),
+			},
+		},
+		{
+			name: "repeated by fall back",
+			expr: "30 1 * * *",
+			loc:  newYork,
+			from: time.Date(2026, 11, 1, 0, 0, 0, 0, newYork),
+			want: []time.Time{
+				time.Date(2026, 11, 1, 5, 30, 0, 0, utc), // 01:30 EDT
+				time.Date(2026, 11, 1, 6, 30, 0, 0, utc), // 01:30 EST
+				time.Date(2026, 11, 2, 6, 30, 0, 0, utc),
+			},
+		},
+		{
+			name: "wall clock hour kept across DST",
+			expr: "0 9 * * *",
+			loc:  newYork,
+			from: time.Date(2026, 3, 7, 12, 0, 0, 0, newYork),
+			want: []time.Time{
+				time.Date(2026, 3, 8, 13, 0, 0, 0, utc), // 09:00 EDT
+				time.Date(2026, 3, 9, 13, 0, 0, 0, utc),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := mustCron(t, tt.expr)
+			at := tt.from
+			for i, want := range tt.want {
+				at = s.Next(at, tt.loc)
+				if !at.Equal(want) {
+					t.Fatalf("fire %d = %s, want %s", i, at.UTC(), want.UTC())
+				}
+			}
+		})
+	}
+}
+
+func TestCronScheduleNextNeverFires(t *testing.T) {
+	for _, expr := range []string{"0 0 30 2 *", "0 0 31 4,6,9,11 *"} {
+		if next := mustCron(t, expr).Next(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC); !next.IsZero() {
+			t.Errorf("%q fires at %s, want never", expr, next)
+		}
+	}
+}
+
+func TestFirstCommonFireTime(t *testing.T) {
+	utc := time.UTC
+	newYork := mustLocation(t, "America/New_York")
+	from := time.Date(2026, 6, 1, 0, 0, 0, 0, utc)
+
+	// 09:00 in New York is 14:00 UTC only while standard time applies.
+	at, ok := FirstCommonFireTime(mustCron(t, "0 9 * * *"), newYork, mustCron(t, "0 14 * * *"), utc, from, 366*24*time.Hour)
+	if !ok || !at.Equal(time.Date(2026, 11, 1, 14, 0, 0, 0, utc)) {
+		t.Fatalf("common fire time = %s, %t, want 2026-11-01T14:00:00Z", at, ok)
+	}
+
+	if at, ok := FirstCommonFireTime(mustCron(t, "0 9 * * 1-5"), utc, mustCron(t, "0 18 * * 1-5"), utc, from, 366*24*time.Hour); ok {
+		t.Fatalf("disjoint schedules overlap at %s", at)
+	}
+
+	// Weekend and weekday schedules at the same hour never meet.
+	if at, ok := FirstCommonFireTime(mustCron(t, "0 0 * * 6"), utc, mustCron(t, "0 0 * * 1-5"), utc, from, 366*24*time.Hour); ok {
+		t.Fatalf("weekday and weekend schedules overlap at %s", at)
+	}
+}
@@ -0,0 +1,165 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DefaultScheduleTimezone is the timezone used by schedule_scaling entries
+// that do not set one.
+const DefaultScheduleTimezone = "Etc/UTC"
+
+// scheduleOverlapHorizon is how far ahead schedules are compared for
+// overlapping fire times.
+const scheduleOverlapHorizon = 366 * 24 * time.Hour
+
+// CronExpressionValidator validates that a string is a 5-field cron
+// expression that fires at least once.
+type CronExpressionValidator struct{}
+
+func (v CronExpressionValidator) Description(_ context.Context) string {
+	return "Must be a 5-field cron expression: minute hour day-of-month month day-of-week."
+}
+
+func (v CronExpressionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v CronExpressionValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	expr := req.ConfigValue.ValueString()
+	schedule, err := ParseCron(expr)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid cron expression",
+			fmt.Sprintf("%q is not a valid cron expression: %s. Expected 5 fields: minute hour day-of-month month day-of-week, for example \"0 9 * * 1-5\".", expr, err))
+		return
+	}
+	if schedule.Next(time.Now(), time.UTC).IsZero() {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid cron expression",
+			fmt.Sprintf("%q never fires, check the day-of-month and month fields.", expr))
+	}
+}
+
+// TimezoneValidator validates that a string is an IANA timezone name.
+type TimezoneValidator struct{}
+
+func (v TimezoneValidator) Description(_ context.Context) string {
+	return "Must be an IANA timezone name, such as Etc/UTC or America/New_York."
+}
+
+func (v TimezoneValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v TimezoneValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	name := req.ConfigValue.ValueString()
+	if _, err := loadScheduleLocation(name); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid timezone", err.Error())
+	}
+}
+
+func loadScheduleLocation(name string) (*time.Location, error) {
+	// LoadLocation maps "" to UTC and "Local" to the host timezone, neither
+	// of which means anything to the API.
+	if name == "" || name == "Local" {
+		return nil, fmt.Errorf("%q is not an IANA timezone name, use a name such as Etc/UTC or America/New_York", name)
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("%q is not an IANA timezone name, use a name such as Etc/UTC or America/New_York", name)
+	}
+	return loc, nil
+}
+
+// ScheduleOverlapValidator warns when two schedule_scaling entries fire at
+// the same minute, since only one of their targets can take effect.
+type ScheduleOverlapValidator struct {
+	// now returns the time overlaps are searched from, time.Now when nil.
+	now func() time.Time
+}
+
+func (v ScheduleOverlapValidator) Description(_ context.Context) string {
+	return "Warns when two schedules fire at the same minute."
+}
+
+func (v ScheduleOverlapValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+type parsedSchedule struct {
+	index    int
+	cron     string
+	schedule *CronSchedule
+	loc      *time.Location
+}
+
+func (v ScheduleOverlapValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var schedules []parsedSchedule
+	for i, elem := range req.ConfigValue.Elements() {
+		obj, ok := elem.(types.Object)
+		if !ok || obj.IsNull() || obj.IsUnknown() {
+			continue
+		}
+		attrs := obj.Attributes()
+		cron, ok := attrs["cron"].(types.String)
+		if !ok || cron.IsNull() || cron.IsUnknown() {
+			continue
+		}
+		timezone := DefaultScheduleTimezone
+		if tz, ok := attrs["timezone"].(types.String); ok && !tz.IsNull() {
+			if tz.IsUnknown() {
+				continue
+			}
+			timezone = tz.ValueString()
+		}
+
+		schedule, err := ParseCron(cron.ValueString())
+		if err != nil {
+			continue // reported by CronExpressionValidator
+		}
+		loc, err := loadScheduleLocation(timezone)
+		if err != nil {
+			continue // reported by TimezoneValidator
+		}
+		schedules = append(schedules, parsedSchedule{index: i, cron: cron.ValueString(), schedule: schedule, loc: loc})
+	}
+
+	now := time.Now
+	if v.now != nil {
+		now = v.now
+	}
+	from := now()
+	for i := 0; i < len(schedules); i++ {
+		for j := i + 1; j < len(schedules); j++ {
+			a, b := schedules[i], schedules[j]
+			at, ok := FirstCommonFireTime(a.schedule, a.loc, b.schedule, b.loc, from, scheduleOverlapHorizon)
+			if !ok {
+				continue
+			}
+			resp.Diagnostics.AddAttributeWarning(req.Path.AtListIndex(b.index), "Overlapping schedules",
+				fmt.Sprintf("Schedule %d (%q in %s) and schedule %d (%q in %s) both fire at %s; only one of their targets takes effect.",
+					a.index, a.cron, a.loc, b.index, b.cron, b.loc, at.UTC().Format(time.RFC3339)))
+		}
+	}
+}
+
+var (
+	_ validator.String = CronExpressionValidator{}
+	_ validator.String = TimezoneValidator{}
+	_ validator.List   = ScheduleOverlapValidator{}
+)
@@ -0,0 +1,147 @@
+package validator
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCronExpressionValidator(t *testing.T) {
+	tests := []struct {
+		expr    string
+		wantErr string
+	}{
+		{expr: "0 9 * * 1-5"},
+		{expr: "0 9 * *", wantErr: "expected 5 fields"},
+		{expr: "0 25 * * *", wantErr: "hour: value 25 out of range"},
+		{expr: "0 0 31 2 *", wantErr: "never fires"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			CronExpressionValidator{}.ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("cron"),
+				ConfigValue: types.StringValue(tt.expr),
+			}, resp)
+			assertSingleDiagnostic(t, resp.Diagnostics.Errors(), tt.wantErr)
+		})
+	}
+}
+
+func TestTimezoneValidator(t *testing.T) {
+	tests := []struct {
+		timezone string
+		wantErr  bool
+	}{
+		{timezone: "Etc/UTC"},
+		{timezone: "UTC"},
+		{timezone: "America/New_York"},
+		{timezone: "Asia/Kolkata"},
+		{timezone: "", wantErr: true},
+		{timezone: "Local", wantErr: true},
+		{timezone: "EST5EDT6", wantErr: true},
+		{timezone: "Mars/Olympus_Mons", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.timezone, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			TimezoneValidator{}.ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("timezone"),
+				ConfigValue: types.StringValue(tt.timezone),
+			}, resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Fatalf("HasError = %t, want %t: %s", resp.Diagnostics.HasError(), tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+var scheduleTestType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"timezone": types.StringType,
+	"cron":     types.StringType,
+	"target":   types.Int64Type,
+}}
+
+func scheduleTestValue(timezone types.String, cron string, target int64) attr.Value {
+	return types.ObjectValueMust(scheduleTestType.AttrTypes, map[string]attr.Value{
+		"timezone": timezone,
+		"cron":     types.StringValue(cron),
+		"target":   types.Int64Value(target),
+	})
+}
+
+func TestScheduleOverlapValidator(t *testing.T) {
+	from := func() time.Time { return time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name        string
+		schedules   []attr.Value
+		wantWarning string
+	}{
+		{
+			name: "distinct times",
+			schedules: []attr.Value{
+				scheduleTestValue(types.StringValue("Etc/UTC"), "0 9 * * 1-5", 8),
+				scheduleTestValue(types.StringValue("Etc/UTC"), "0 18 * * 1-5", 2),
+			},
+		},
+		{
+			name: "same minute",
+			schedules: []attr.Value{
+				scheduleTestValue(types.StringValue("Etc/UTC"), "0 9 * * *", 8),
+				scheduleTestValue(types.StringNull(), "0 9 * * 1", 4),
+			},
+			wantWarning: "both fire at 2026-06-01T09:00:00Z",
+		},
+		{
+			name: "overlap after DST ends",
+			schedules: []attr.Value{
+				scheduleTestValue(types.StringValue("America/New_York"), "0 9 * * *", 8),
+				scheduleTestValue(types.StringValue("Etc/UTC"), "0 14 * * *", 2),
+			},
+			wantWarning: "both fire at 2026-11-01T14:00:00Z",
+		},
+		{
+			name: "invalid entries are left to the attribute validators",
+			schedules: []attr.Value{
+				scheduleTestValue(types.StringValue("Etc/UTC"), "0 9 * *", 8),
+				scheduleTestValue(types.StringValue("Nowhere"), "0 9 * * *", 2),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &validator.ListResponse{}
+			ScheduleOverlapValidator{now: from}.ValidateList(context.Background(), validator.ListRequest{
+				Path:        path.Root("schedule_scaling"),
+				ConfigValue: types.ListValueMust(scheduleTestType, tt.schedules),
+			}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected errors: %s", resp.Diagnostics)
+			}
+			assertSingleDiagnostic(t, resp.Diagnostics.Warnings(), tt.wantWarning)
+		})
+	}
+}
+
+func assertSingleDiagnostic[T interface{ Detail() string }](t *testing.T, diags []T, want string) {
+	t.Helper()
+	if want == "" {
+		if len(diags) != 0 {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		return
+	}
+	if len(diags) != 1 || !strings.Contains(diags[0].Detail(), want) {
+		t.Fatalf("diagnostics = %v, want one containing %q", diags, want)
+	}
+}
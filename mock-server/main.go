@@ -26,6 +26,8 @@ func main() {
 		v2.GET("/projects/:projectId", byoc_project.GetProjectById)
 		v2.PATCH("/projects/:projectId/plan", byoc_project.UpgradeProjectPlan)
 		v2.DELETE("/projects/:projectId", byoc_project.DeleteProject)
+		v2.GET("/regions", byoc_project.ListRegions)
+		v2.GET("/regions/:regionId/milvusVersions", byoc_project.ListMilvusVersions)
 
 		clusters := v2.Group("/clusters")
 		{
@@ -35,13 +37,21 @@ func main() {
 			clusters.GET("/:clusterId", byoc_project.GetCluster)
 			clusters.POST("/:clusterId/resume", byoc_project.ResumeCluster)
 			clusters.POST("/:clusterId/suspend", byoc_project.SuspendCluster)
+			clusters.PUT("/:clusterId/suspendSchedule", byoc_project.UpdateSuspendSchedule)
+			clusters.DELETE("/:clusterId/suspendSchedule", byoc_project.DeleteSuspendSchedule)
+			clusters.PUT("/:clusterId/maintenanceWindow", byoc_project.UpdateMaintenanceWindow)
+			clusters.DELETE("/:clusterId/maintenanceWindow", byoc_project.DeleteMaintenanceWindow)
 			clusters.POST("/:clusterId/modifyReplica", byoc_project.ModifyClusterReplica)
 			clusters.POST("/:clusterId/modify", byoc_project.ModifyCluster)
 			clusters.POST("/:clusterId/modifyProperties", byoc_project.ModifyClusterProperties)
+			clusters.POST("/:clusterId/upgradeVersion", byoc_project.UpgradeClusterVersion)
+			clusters.POST("/:clusterId/resetPassword", byoc_project.ResetClusterPassword)
 			clusters.GET("/:clusterId/labels", byoc_project.GetLabels)
 			clusters.PUT("/:clusterId/labels", byoc_project.UpdateLabels)
 			clusters.GET("/:clusterId/securityGroups", byoc_project.GetSecurityGroups)
 			clusters.PUT("/:clusterId/securityGroups", byoc_project.UpsertSecurityGroups)
+			clusters.GET("/:clusterId/ipAllowlist", byoc_project.GetIpAllowlist)
+			clusters.PUT("/:clusterId/ipAllowlist", byoc_project.UpsertIpAllowlist)
 			clusters.DELETE("/:clusterId/drop", byoc_project.DropCluster)
 		}
 		globalClusters := v2.Group("/globalClusters")
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -78,8 +79,16 @@ func CreateDedicatedCluster(c *gin.Context) {
 			}
 			return ""
 		}(),
-		Replica:     replica,
-		Autoscaling: request.Autoscaling,
+		Replica:               replica,
+		Autoscaling:           request.Autoscaling,
+		PublicEndpointEnabled: request.PublicEndpointEnabled,
+		MaintenanceWindow:     request.MaintenanceWindow,
+		MilvusVersion: func() string {
+			if request.MilvusVersion != "" {
+				return request.MilvusVersion
+			}
+			return defaultMilvusVersion
+		}(),
 	}
 	cluster.Status = "CREATING"
 	clusterStore.Set(clusterId, cluster)
@@ -465,6 +474,248 @@ func UpdateLabels(c *gin.Context) {
 	})
 }
 
+func UpdateSuspendSchedule(c *gin.Context) {
+	clusterId := c.Param("clusterId")
+
+	if clusterId == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "clusterId is required"})
+		return
+	}
+
+	var request SuspendSchedule
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cluster := clusterStore.Get(clusterId)
+	if cluster == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "cluster not found"})
+		return
+	}
+
+	if cluster.Plan == "Free" || cluster.Plan == "Serverless" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "suspend schedule is not supported for " + cluster.Plan + " clusters"})
+		return
+	}
+
+	if request.Timezone == "" {
+		request.Timezone = "Etc/UTC"
+	}
+
+	log.Printf("[UpdateSuspendSchedule] clusterId: %s, suspend %q, resume %q in %s", clusterId, request.SuspendCron, request.ResumeCron, request.Timezone)
+
+	cluster.SuspendSchedule = &request
+	clusterStore.Set(clusterId, cluster)
+
+	c.JSON(http.StatusOK, gin.H{
+		"code": 0,
+		"data": gin.H{
+			"clusterId": clusterId,
+		},
+	})
+}
+
+func DeleteSuspendSchedule(c *gin.Context) {
+	clusterId := c.Param("clusterId")
+
+	if clusterId == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "clusterId is required"})
+		return
+	}
+
+	cluster := clusterStore.Get(clusterId)
+	if cluster == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "cluster not found"})
+		return
+	}
+
+	log.Printf("[DeleteSuspendSchedule] clusterId: %s", clusterId)
+
+	cluster.SuspendSchedule = nil
+	clusterStore.Set(clusterId, cluster)
+
+	c.JSON(http.StatusOK, gin.H{
+		"code": 0,
+		"data": gin.H{
+			"clusterId": clusterId,
+		},
+	})
+}
+
+func UpdateMaintenanceWindow(c *gin.Context) {
+	clusterId := c.Param("clusterId")
+
+	if clusterId == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "clusterId is required"})
+		return
+	}
+
+	var request MaintenanceWindow
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cluster := clusterStore.Get(clusterId)
+	if cluster == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "cluster not found"})
+		return
+	}
+
+	if cluster.Plan == "Free" || cluster.Plan == "Serverless" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "maintenance window is not supported for " + cluster.Plan + " clusters"})
+		return
+	}
+
+	if request.Timezone == "" {
+		request.Timezone = "Etc/UTC"
+	}
+
+	log.Printf("[UpdateMaintenanceWindow] clusterId: %s, %s %02d:00 for %dh in %s", clusterId, request.DayOfWeek, request.StartHour, request.Duration, request.Timezone)
+
+	cluster.MaintenanceWindow = &request
+	clusterStore.Set(clusterId, cluster)
+
+	c.JSON(http.StatusOK, gin.H{
+		"code": 0,
+		"data": gin.H{
+			"clusterId": clusterId,
+		},
+	})
+}
+
+func DeleteMaintenanceWindow(c *gin.Context) {
+	clusterId := c.Param("clusterId")
+
+	if clusterId == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "clusterId is required"})
+		return
+	}
+
+	cluster := clusterStore.Get(clusterId)
+	if cluster == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "cluster not found"})
+		return
+	}
+
+	log.Printf("[DeleteMaintenanceWindow] clusterId: %s", clusterId)
+
+	cluster.MaintenanceWindow = nil
+	clusterStore.Set(clusterId, cluster)
+
+	c.JSON(http.StatusOK, gin.H{
+		"code": 0,
+		"data": gin.H{
+			"clusterId": clusterId,
+		},
+	})
+}
+
+type UpgradeClusterVersionRequest struct {
+	TargetVersion string `json:"targetVersion" binding:"required"`
+}
+
+func UpgradeClusterVersion(c *gin.Context) {
+	clusterId := c.Param("clusterId")
+
+	if clusterId == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "clusterId is required"})
+		return
+	}
+
+	var request UpgradeClusterVersionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cluster := clusterStore.Get(clusterId)
+	if cluster == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "cluster not found"})
+		return
+	}
+
+	if cluster.Plan == "Free" || cluster.Plan == "Serverless" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "version upgrade is not supported for " + cluster.Plan + " clusters"})
+		return
+	}
+
+	target := slices.Index(milvusVersions, request.TargetVersion)
+	if target < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "milvus version " + request.TargetVersion + " is not available"})
+		return
+	}
+	if target < slices.Index(milvusVersions, cluster.MilvusVersion) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "cannot downgrade milvus from " + cluster.MilvusVersion + " to " + request.TargetVersion})
+		return
+	}
+
+	log.Printf("[UpgradeClusterVersion] clusterId: %s, %s -> %s", clusterId, cluster.MilvusVersion, request.TargetVersion)
+
+	cluster.Status = "UPGRADING"
+	clusterStore.Set(clusterId, cluster)
+
+	go func() {
+		time.Sleep(TimeToChange)
+		cluster := clusterStore.Get(clusterId)
+		if cluster != nil {
+			cluster.MilvusVersion = request.TargetVersion
+			cluster.Status = "RUNNING"
+			clusterStore.Set(clusterId, cluster)
+			log.Printf("[UpgradeClusterVersion] clusterId: %s upgraded to %s", clusterId, request.TargetVersion)
+		}
+	}()
+
+	c.JSON(http.StatusOK, gin.H{
+		"code": 0,
+		"data": gin.H{
+			"clusterId": clusterId,
+		},
+	})
+}
+
+type ResetClusterPasswordRequest struct {
+	UserName string `json:"userName" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+func ResetClusterPassword(c *gin.Context) {
+	clusterId := c.Param("clusterId")
+
+	if clusterId == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "clusterId is required"})
+		return
+	}
+
+	var request ResetClusterPasswordRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cluster := clusterStore.Get(clusterId)
+	if cluster == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "cluster not found"})
+		return
+	}
+
+	log.Printf("[ResetClusterPassword] clusterId: %s, userName: %s", clusterId, request.UserName)
+
+	// Only the default user is tracked, other users live in the data plane.
+	if request.UserName == cluster.Username {
+		cluster.Password = request.Password
+		clusterStore.Set(clusterId, cluster)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code": 0,
+		"data": gin.H{
+			"clusterId": clusterId,
+		},
+	})
+}
+
 func ModifyClusterProperties(c *gin.Context) {
 	clusterId := c.Param("clusterId")
 
@@ -485,9 +736,18 @@ func ModifyClusterProperties(c *gin.Context) {
 		return
 	}
 
-	log.Printf("[ModifyClusterProperties] clusterId: %s, changing cluster name from %s to %s", clusterId, cluster.ClusterName, request.ClusterName)
+	log.Printf("[ModifyClusterProperties] clusterId: %s, request: %+v", clusterId, request)
 
-	cluster.ClusterName = request.ClusterName
+	// Only the properties present in the request are changed.
+	if request.ClusterName != "" {
+		cluster.ClusterName = request.ClusterName
+	}
+	if request.Description != nil {
+		cluster.Description = *request.Description
+	}
+	if request.PublicEndpointEnabled != nil {
+		cluster.PublicEndpointEnabled = request.PublicEndpointEnabled
+	}
 	clusterStore.Set(clusterId, cluster)
 
 	c.JSON(http.StatusOK, gin.H{
@@ -556,3 +816,65 @@ func UpsertSecurityGroups(c *gin.Context) {
 		},
 	})
 }
+
+func GetIpAllowlist(c *gin.Context) {
+	clusterId := c.Param("clusterId")
+
+	if clusterId == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "clusterId is required"})
+		return
+	}
+
+	cluster := clusterStore.Get(clusterId)
+	if cluster == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "cluster not found"})
+		return
+	}
+
+	log.Printf("[GetIpAllowlist] clusterId: %s", clusterId)
+
+	response := GetIpAllowlistResponse{
+		Entries: cluster.IpAllowlist,
+	}
+	if response.Entries == nil {
+		response.Entries = []IpAllowlistEntry{}
+	}
+
+	c.JSON(http.StatusOK, Response[GetIpAllowlistResponse]{
+		Code: 0,
+		Data: response,
+	})
+}
+
+func UpsertIpAllowlist(c *gin.Context) {
+	clusterId := c.Param("clusterId")
+
+	if clusterId == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "clusterId is required"})
+		return
+	}
+
+	var request UpsertIpAllowlistRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cluster := clusterStore.Get(clusterId)
+	if cluster == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "cluster not found"})
+		return
+	}
+
+	log.Printf("[UpsertIpAllowlist] clusterId: %s, updating ip allowlist from %v to %v", clusterId, cluster.IpAllowlist, request.Entries)
+
+	cluster.IpAllowlist = request.Entries
+	clusterStore.Set(clusterId, cluster)
+
+	c.JSON(http.StatusOK, gin.H{
+		"code": 0,
+		"data": gin.H{
+			"clusterId": clusterId,
+		},
+	})
+}
@@ -348,6 +348,41 @@ func ModifyCluster(c *gin.Context) {
 		return
 	}
 
+	if request.Plan != "" || request.CuType != "" {
+		log.Printf("[ModifyCluster] clusterId: %s, changing plan from %q to %q and cuType from %q to %q, status: RUNNING -> MODIFYING -> RUNNING", clusterId, cluster.Plan, request.Plan, cluster.CuType, request.CuType)
+		if request.Plan != "" {
+			cluster.Plan = request.Plan
+		}
+		if request.CuType != "" {
+			cluster.CuType = request.CuType
+		}
+		cluster.Status = "MODIFYING"
+		clusterStore.Set(clusterId, cluster)
+
+		go func() {
+			time.Sleep(10 * time.Second)
+			cluster := clusterStore.Get(clusterId)
+			if cluster != nil {
+				cluster.Status = "RUNNING"
+				clusterStore.Set(clusterId, cluster)
+				log.Printf("[ModifyCluster] clusterId: %s status changed to RUNNING", clusterId)
+			}
+		}()
+
+		c.JSON(http.StatusOK, gin.H{
+			"code": 0,
+			"data": gin.H{
+				"clusterId": clusterId,
+			},
+		})
+		return
+	}
+
+	if request.CuSize == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "one of cuSize, autoscaling, plan or cuType is required"})
+		return
+	}
+
 	log.Printf("[ModifyCluster] clusterId: %s, changing cuSize from %d to %d, status: RUNNING -> MODIFYING -> RUNNING", clusterId, cluster.CuSize, *request.CuSize)
 
 	cluster.Status = "MODIFYING"
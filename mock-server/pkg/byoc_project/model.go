@@ -411,6 +411,10 @@ type CreateDedicatedClusterRequest struct {
 	Labels       map[string]string  `json:"labels"`
 	BucketInfo   *ClusterBucketInfo `json:"bucketInfo,omitempty"`
 	AwsCseKeyArn *string            `json:"keyIdentifier,omitempty"`
+
+	PublicEndpointEnabled *bool              `json:"publicEndpointEnabled,omitempty"`
+	MaintenanceWindow     *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+	MilvusVersion         string             `json:"milvusVersion,omitempty"`
 }
 
 type ClusterBucketInfo struct {
@@ -444,6 +448,31 @@ type DedicatedClusterResponse struct {
 	BucketInfo         *ClusterBucketInfo `json:"bucketInfo,omitempty"`
 	AwsCseKeyArn       string             `json:"keyIdentifier,omitempty"`
 	GlobalClusterMeta  *GlobalClusterMeta `json:"globalClusterMeta"`
+
+	SuspendSchedule       *SuspendSchedule    `json:"suspendSchedule,omitempty"`
+	PublicEndpointEnabled *bool               `json:"publicEndpointEnabled,omitempty"`
+	IpAllowlist           []IpAllowlistEntry  `json:"ipAllowlist,omitempty"`
+	MaintenanceWindow     *MaintenanceWindow  `json:"maintenanceWindow,omitempty"`
+	PendingMaintenance    *PendingMaintenance `json:"pendingMaintenance,omitempty"`
+	MilvusVersion         string              `json:"milvusVersion,omitempty"`
+}
+
+type MaintenanceWindow struct {
+	DayOfWeek string `json:"dayOfWeek" binding:"required"`
+	StartHour int    `json:"startHour"`
+	Timezone  string `json:"timezone"`
+	Duration  int    `json:"duration" binding:"required"`
+}
+
+type PendingMaintenance struct {
+	StartTime   string `json:"startTime"`
+	Description string `json:"description"`
+}
+
+type SuspendSchedule struct {
+	SuspendCron string `json:"suspendCron" binding:"required"`
+	ResumeCron  string `json:"resumeCron" binding:"required"`
+	Timezone    string `json:"timezone"`
 }
 
 type GlobalClusterMeta struct {
@@ -498,6 +527,8 @@ type ModifyReplicaRequest struct {
 type ModifyClusterRequest struct {
 	CuSize      *int         `json:"cuSize,omitempty"`
 	Autoscaling *Autoscaling `json:"autoscaling,omitempty"`
+	Plan        string       `json:"plan,omitempty"`
+	CuType      string       `json:"cuType,omitempty"`
 }
 
 type GlobalClusterMemberRequest struct {
@@ -558,7 +589,9 @@ type UpdateLabelsRequest struct {
 }
 
 type ModifyPropertiesRequest struct {
-	ClusterName string `json:"clusterName"`
+	ClusterName           string  `json:"clusterName"`
+	Description           *string `json:"description"`
+	PublicEndpointEnabled *bool   `json:"publicEndpointEnabled"`
 }
 
 // Serverless cluster types
@@ -631,3 +664,16 @@ type UpsertSecurityGroupsRequest struct {
 type GetSecurityGroupsResponse struct {
 	Ids []string `json:"ids,omitempty"`
 }
+
+type IpAllowlistEntry struct {
+	Cidr        string `json:"cidr"`
+	Description string `json:"description"`
+}
+
+type UpsertIpAllowlistRequest struct {
+	Entries []IpAllowlistEntry `json:"entries"`
+}
+
+type GetIpAllowlistResponse struct {
+	Entries []IpAllowlistEntry `json:"entries"`
+}
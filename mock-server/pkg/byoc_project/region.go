@@ -0,0 +1,89 @@
+package byoc_project
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// regions lists the regions served by the mock server, with the capability
+// metadata the provider checks at plan time.
+var regions = []gin.H{
+	{
+		"cloudId":               "aws",
+		"regionId":              "aws-us-west-2",
+		"domain":                "api.cloud.zilliz.com",
+		"supportedClusterTypes": []string{"free", "serverless", "dedicated"},
+		"capabilities": gin.H{
+			"plans": []string{"Standard", "Enterprise", "BusinessCritical"},
+			"cuTypes": []gin.H{
+				{"cuType": "Performance-optimized", "cuSizes": []int{1, 2, 4, 6, 8, 12, 16, 20, 24, 28, 32}, "maxReplica": 10},
+				{"cuType": "Capacity-optimized", "cuSizes": []int{1, 2, 4, 6, 8, 12, 16, 20, 24, 28, 32}, "maxReplica": 10},
+				{"cuType": "Tiered-storage", "cuSizes": []int{8, 16, 32}, "plans": []string{"Enterprise", "BusinessCritical"}},
+			},
+		},
+	},
+	{
+		"cloudId":               "gcp",
+		"regionId":              "gcp-us-west1",
+		"domain":                "api.cloud.zilliz.com",
+		"supportedClusterTypes": []string{"free", "serverless", "dedicated"},
+		"capabilities": gin.H{
+			"plans": []string{"Standard", "Enterprise"},
+			"cuTypes": []gin.H{
+				{"cuType": "Performance-optimized", "cuSizes": []int{1, 2, 4, 6, 8, 12, 16}, "maxReplica": 4},
+				{"cuType": "Capacity-optimized", "cuSizes": []int{1, 2, 4, 6, 8, 12, 16}, "maxReplica": 4},
+			},
+		},
+	},
+}
+
+// ListRegions returns the mock regions, optionally filtered by cloudId
+func ListRegions(c *gin.Context) {
+	cloudId := c.Query("cloudId")
+
+	list := make([]gin.H, 0, len(regions))
+	for _, region := range regions {
+		if cloudId != "" && region["cloudId"] != cloudId {
+			continue
+		}
+		list = append(list, region)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code": 0,
+		"data": list,
+	})
+}
+
+// milvusVersions lists the Milvus versions of every mock region, in ascending order.
+var milvusVersions = []string{"2.4.17", "2.5.4"}
+
+const defaultMilvusVersion = "2.4.17"
+
+// ListMilvusVersions returns the Milvus versions available in a region
+func ListMilvusVersions(c *gin.Context) {
+	regionId := c.Param("regionId")
+
+	found := false
+	for _, region := range regions {
+		if region["regionId"] == regionId {
+			found = true
+			break
+		}
+	}
+	if !found {
+		c.JSON(http.StatusNotFound, gin.H{"error": "region not found"})
+		return
+	}
+
+	list := make([]gin.H, 0, len(milvusVersions))
+	for _, version := range milvusVersions {
+		list = append(list, gin.H{"version": version, "isDefault": version == defaultMilvusVersion})
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code": 0,
+		"data": list,
+	})
+}